@@ -0,0 +1,180 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"log/slog"
+	"os"
+	"time"
+
+	"server-template/config"
+	"server-template/internal/infrastructure/rpc"
+	"server-template/proto/pb/adminpb"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc"
+)
+
+const usage = `Usage: admin <command> [flags]
+
+Commands:
+  export  Export all personal data of a user as a JSON archive
+  erase   Anonymise a user and revoke all of their sessions (irreversible)
+
+Run "admin <command> --help" for the flags of each command.
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "export":
+		err = runExport(os.Args[2:])
+	case "erase":
+		err = runErase(os.Args[2:])
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	if err != nil {
+		log.Fatalf("admin %s, err: %+v", os.Args[1], err)
+	}
+}
+
+// commonFlags 為各指令共用的連線參數
+type commonFlags struct {
+	target        string
+	authorization string
+	timeout       time.Duration
+	userID        string
+}
+
+func newFlagSet(name string) (*pflag.FlagSet, *commonFlags) {
+	flags := pflag.NewFlagSet(name, pflag.ExitOnError)
+	common := &commonFlags{}
+
+	flags.StringVar(&common.target, "target", "", "Auth service address, defaults to rpc.clients.auth.target in config")
+	flags.StringVar(&common.authorization, "authorization", os.Getenv("ADMIN_AUTHORIZATION"),
+		`Authorization of the caller ("Bearer <token>" or "ApiKey <key>"), defaults to $ADMIN_AUTHORIZATION`)
+	flags.DurationVar(&common.timeout, "timeout", 30*time.Second, "Request timeout")
+	flags.StringVar(&common.userID, "user-id", "", "ID of the user")
+
+	return flags, common
+}
+
+func runExport(args []string) error {
+	flags, common := newFlagSet("export")
+	output := flags.String("output", "", "Write the archive to this file instead of stdout")
+	if err := flags.Parse(args); err != nil {
+		return errors.WithStack(err)
+	}
+
+	client, ctx, cancel, err := common.dial()
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
+	req := &adminpb.ExportUserRequest{}
+	req.SetUserId(common.userID)
+
+	resp, err := client.ExportUser(ctx, req)
+	if err != nil {
+		return errors.Wrap(err, "failed to export user")
+	}
+	if resp.GetStatus().GetCode() != int32(0) {
+		return errors.New(resp.GetStatus().GetMessage())
+	}
+
+	if *output == "" {
+		_, err = os.Stdout.Write(resp.GetArchive())
+
+		return errors.WithStack(err)
+	}
+
+	// 匯出內容包含個人資料，僅允許擁有者讀取
+	if err := os.WriteFile(*output, resp.GetArchive(), 0o600); err != nil {
+		return errors.Wrap(err, "failed to write archive")
+	}
+	log.Printf("User %s exported to %s", common.userID, *output)
+
+	return nil
+}
+
+func runErase(args []string) error {
+	flags, common := newFlagSet("erase")
+	if err := flags.Parse(args); err != nil {
+		return errors.WithStack(err)
+	}
+
+	client, ctx, cancel, err := common.dial()
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
+	req := &adminpb.EraseUserRequest{}
+	req.SetUserId(common.userID)
+
+	resp, err := client.EraseUser(ctx, req)
+	if err != nil {
+		return errors.Wrap(err, "failed to erase user")
+	}
+	if resp.GetStatus().GetCode() != int32(0) {
+		return errors.New(resp.GetStatus().GetMessage())
+	}
+	log.Printf("User %s erased, %d sessions revoked", common.userID, resp.GetRevokedSessions())
+
+	return nil
+}
+
+// dial 建立 Admin 服務的客戶端，回傳的 context 已帶上呼叫者的憑證與逾時
+func (f *commonFlags) dial() (adminpb.AdminClient, context.Context, context.CancelFunc, error) {
+	if f.userID == "" {
+		return nil, nil, nil, errors.New("--user-id is required")
+	}
+	if f.authorization == "" {
+		return nil, nil, nil, errors.New("--authorization or $ADMIN_AUTHORIZATION is required")
+	}
+
+	// 設定檔中的 TLS 設定一併套用；以 --target 指定且沒有設定檔時為不加密的連線
+	var clientConfig config.RPCClientConfig
+	cfg, err := config.New()
+	switch {
+	case err == nil:
+		clientConfig = cfg.RPC.Clients[string(rpc.AuthClient)]
+	case f.target == "":
+		return nil, nil, nil, errors.Wrap(err, "failed to load config")
+	}
+	if f.target != "" {
+		clientConfig.Target = f.target
+	}
+
+	creds, err := rpc.ClientCredentials(clientConfig.TLS, slog.Default())
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to create client credentials")
+	}
+
+	conn, err := grpc.NewClient(clientConfig.Target,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithChainUnaryInterceptor(rpc.UnaryClientErrorInterceptor()),
+	)
+	if err != nil {
+		return nil, nil, nil, errors.WithStack(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), f.timeout)
+	ctx = rpc.WithAuthorization(ctx, f.authorization)
+
+	return adminpb.NewAdminClient(conn), ctx, func() {
+		cancel()
+		_ = conn.Close()
+	}, nil
+}
@@ -12,12 +12,24 @@ import (
 func main() {
 	models := []any{
 		entity.User{},
+		entity.MFASetting{},
+		entity.MFARecoveryCode{},
+		entity.Role{},
+		entity.Permission{},
+		entity.RolePermission{},
+		entity.UserRole{},
+		entity.APIKey{},
+		entity.UserIdentity{},
+		entity.OAuthClient{},
+		entity.AuditEvent{},
 	}
 
+	const outputPath = "./database/migrations/postgres"
+
 	sql := gem.New(&gem.Config{
 		Tool:              gem.Goose,
 		QuoteChar:         '"',
-		OutputPath:        "./database/migrations/postgres",
+		OutputPath:        outputPath,
 		KeepDroppedColumn: true,
 	})
 
@@ -27,6 +39,10 @@ func main() {
 		log.Fatalf("run migrator, err: %+v", err)
 	}
 
+	if err := rewritePostgresMigrations(outputPath); err != nil {
+		log.Fatalf("rewrite migrations, err: %+v", err)
+	}
+
 	gen := gen.NewGenerator(gen.Config{
 		OutPath: "./internal/repository/gen/query",
 	})
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// gem 僅會產生 MySQL 語法的 ALTER 語句，需改寫為 PostgreSQL 語法
+	modifyColumnPattern   = regexp.MustCompile(`(?m)^ALTER TABLE (\S+) MODIFY COLUMN (\S+) (.+);$`)
+	columnPositionPattern = regexp.MustCompile(`(?m)^(ALTER TABLE \S+ ADD COLUMN .+?)\s+(?:FIRST|AFTER \S+);$`)
+	dropIndexPattern      = regexp.MustCompile(`(?m)^(DROP INDEX \S+) ON \S+;$`)
+)
+
+// rewritePostgresMigrations 將輸出目錄中 gem 產生的 MySQL 專用語法改寫為 PostgreSQL 語法：
+// MODIFY COLUMN 改為 ALTER COLUMN ... TYPE，並移除 ADD COLUMN 的 FIRST/AFTER 位置子句與 DROP INDEX 的 ON 子句
+func rewritePostgresMigrations(dir string) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.sql"))
+	if err != nil {
+		return errors.Wrap(err, "failed to list migrations")
+	}
+
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return errors.Wrapf(err, "failed to read %s", file)
+		}
+
+		rewritten := modifyColumnPattern.ReplaceAllStringFunc(string(content), func(statement string) string {
+			match := modifyColumnPattern.FindStringSubmatch(statement)
+
+			return postgresAlterColumn(match[1], match[2], strings.Fields(match[3]))
+		})
+		rewritten = columnPositionPattern.ReplaceAllString(rewritten, "$1;")
+		rewritten = dropIndexPattern.ReplaceAllString(rewritten, "$1;")
+
+		if rewritten == string(content) {
+			continue
+		}
+		if err := os.WriteFile(file, []byte(rewritten), 0o600); err != nil {
+			return errors.Wrapf(err, "failed to write %s", file)
+		}
+	}
+
+	return nil
+}
+
+// postgresAlterColumn 將 MODIFY COLUMN 的欄位定義拆為型別、NULL 與預設值的變更
+func postgresAlterColumn(table, column string, definition []string) string {
+	typeEnd := len(definition)
+	for i, token := range definition {
+		if upper := strings.ToUpper(token); upper == "NOT" || upper == "NULL" || upper == "DEFAULT" {
+			typeEnd = i
+
+			break
+		}
+	}
+
+	actions := []string{"ALTER COLUMN " + column + " TYPE " + strings.Join(definition[:typeEnd], " ")}
+	constraints := definition[typeEnd:]
+	for i := 0; i < len(constraints); i++ {
+		switch strings.ToUpper(constraints[i]) {
+		case "NOT":
+			actions = append(actions, "ALTER COLUMN "+column+" SET NOT NULL")
+			i++
+		case "NULL":
+			actions = append(actions, "ALTER COLUMN "+column+" DROP NOT NULL")
+		case "DEFAULT":
+			end := i + 1
+			for end < len(constraints) && !strings.EqualFold(constraints[end], "NOT") && !strings.EqualFold(constraints[end], "NULL") {
+				end++
+			}
+			actions = append(actions, "ALTER COLUMN "+column+" SET DEFAULT "+strings.Join(constraints[i+1:end], " "))
+			i = end - 1
+		}
+	}
+
+	return "ALTER TABLE " + table + " " + strings.Join(actions, ", ") + ";"
+}
@@ -12,10 +12,19 @@ import (
 	"server-template/internal/domain/delivery"
 	repo "server-template/internal/domain/repository"
 	use "server-template/internal/domain/usecase"
+	"server-template/internal/infrastructure/audit"
+	"server-template/internal/infrastructure/health"
+	"server-template/internal/infrastructure/idempotency"
+	"server-template/internal/infrastructure/identity"
+	"server-template/internal/infrastructure/jwtkey"
 	"server-template/internal/infrastructure/logs"
+	"server-template/internal/infrastructure/notification"
 	"server-template/internal/infrastructure/observability/otel"
 	"server-template/internal/infrastructure/observability/profiler"
 	"server-template/internal/infrastructure/observability/pyroscope"
+	"server-template/internal/infrastructure/password"
+	"server-template/internal/infrastructure/ratelimit"
+	"server-template/internal/infrastructure/revocation"
 	"server-template/internal/infrastructure/rpc"
 	"server-template/internal/repository"
 	"server-template/internal/repository/conn/mongo"
@@ -54,6 +63,12 @@ func injectInfra() fx.Option {
 	return fx.Provide(
 		config.New,
 		logs.New,
+		jwtkey.New,
+		notification.New,
+		audit.New,
+		identity.New,
+		password.New,
+		password.NewPolicy,
 		context.Background,
 	)
 }
@@ -67,6 +82,10 @@ func injectConn() fx.Option {
 			redis.New,
 			mongo.New,
 			rpc.New,
+			revocation.New,
+			ratelimit.New,
+			idempotency.New,
+			health.New,
 		),
 	)
 }
@@ -75,14 +94,77 @@ func injectRepo() fx.Option {
 	return fx.Options(
 		fx.Provide(
 			repository.NewAuthRPC,
+			repository.NewAdminRPC,
+			repository.NewSessionRepository,
+			repository.NewOneTimeTokenRepository,
+			repository.NewMFAChallengeRepository,
+			repository.NewLoginAttemptRepository,
+			repository.NewOIDCStateRepository,
 			fx.Annotate(
 				repository.NewUserRepository,
 				fx.ParamTags(`name:"default_postgres"`),
 			),
+			fx.Annotate(
+				repository.NewMFARepository,
+				fx.ParamTags(`name:"default_postgres"`),
+			),
+			fx.Annotate(
+				repository.NewRoleRepository,
+				fx.ParamTags(`name:"default_postgres"`),
+			),
+			fx.Annotate(
+				repository.NewAPIKeyRepository,
+				fx.ParamTags(`name:"default_postgres"`),
+			),
+			fx.Annotate(
+				repository.NewUserIdentityRepository,
+				fx.ParamTags(`name:"default_postgres"`),
+			),
+			fx.Annotate(
+				repository.NewOAuthClientRepository,
+				fx.ParamTags(`name:"default_postgres"`),
+			),
+			fx.Annotate(
+				repository.NewAuditEventRepository,
+				fx.ParamTags(`name:"default_postgres"`),
+			),
 		),
 		fx.Decorate(func(cfg *config.Config, base repo.UserRepository) repo.UserRepository {
 			return repository.ProvideUserRepositoryProxy(cfg.Observability.Otel.Enable, base)
 		}),
+		fx.Decorate(func(cfg *config.Config, base repo.SessionRepository) repo.SessionRepository {
+			return repository.ProvideSessionRepositoryProxy(cfg.Observability.Otel.Enable, base)
+		}),
+		fx.Decorate(func(cfg *config.Config, base repo.OneTimeTokenRepository) repo.OneTimeTokenRepository {
+			return repository.ProvideOneTimeTokenRepositoryProxy(cfg.Observability.Otel.Enable, base)
+		}),
+		fx.Decorate(func(cfg *config.Config, base repo.MFARepository) repo.MFARepository {
+			return repository.ProvideMFARepositoryProxy(cfg.Observability.Otel.Enable, base)
+		}),
+		fx.Decorate(func(cfg *config.Config, base repo.MFAChallengeRepository) repo.MFAChallengeRepository {
+			return repository.ProvideMFAChallengeRepositoryProxy(cfg.Observability.Otel.Enable, base)
+		}),
+		fx.Decorate(func(cfg *config.Config, base repo.LoginAttemptRepository) repo.LoginAttemptRepository {
+			return repository.ProvideLoginAttemptRepositoryProxy(cfg.Observability.Otel.Enable, base)
+		}),
+		fx.Decorate(func(cfg *config.Config, base repo.RoleRepository) repo.RoleRepository {
+			return repository.ProvideRoleRepositoryProxy(cfg.Observability.Otel.Enable, base)
+		}),
+		fx.Decorate(func(cfg *config.Config, base repo.APIKeyRepository) repo.APIKeyRepository {
+			return repository.ProvideAPIKeyRepositoryProxy(cfg.Observability.Otel.Enable, base)
+		}),
+		fx.Decorate(func(cfg *config.Config, base repo.UserIdentityRepository) repo.UserIdentityRepository {
+			return repository.ProvideUserIdentityRepositoryProxy(cfg.Observability.Otel.Enable, base)
+		}),
+		fx.Decorate(func(cfg *config.Config, base repo.OIDCStateRepository) repo.OIDCStateRepository {
+			return repository.ProvideOIDCStateRepositoryProxy(cfg.Observability.Otel.Enable, base)
+		}),
+		fx.Decorate(func(cfg *config.Config, base repo.OAuthClientRepository) repo.OAuthClientRepository {
+			return repository.ProvideOAuthClientRepositoryProxy(cfg.Observability.Otel.Enable, base)
+		}),
+		fx.Decorate(func(cfg *config.Config, base repo.AuditEventRepository) repo.AuditEventRepository {
+			return repository.ProvideAuditEventRepositoryProxy(cfg.Observability.Otel.Enable, base)
+		}),
 	)
 }
 
@@ -91,10 +173,15 @@ func injectUse() fx.Option {
 		fx.Provide(
 			usecase.NewAuthUseCase,
 			usecase.NewAuthHTTPUseCase,
+			usecase.NewAdminUseCase,
+			usecase.NewAdminHTTPUseCase,
 		),
 		fx.Decorate(func(cfg *config.Config, base use.AuthUseCase) use.AuthUseCase {
 			return usecase.ProvideAuthUseCaseProxy(cfg.Observability.Otel.Enable, base)
 		}),
+		fx.Decorate(func(cfg *config.Config, base use.AdminUseCase) use.AdminUseCase {
+			return usecase.ProvideAdminUseCaseProxy(cfg.Observability.Otel.Enable, base)
+		}),
 	)
 }
 
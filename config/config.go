@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/go-viper/mapstructure/v2"
 	"github.com/pkg/errors"
 	"github.com/slighter12/go-lib/database/mongo"
 	"github.com/slighter12/go-lib/database/mysql"
@@ -33,6 +34,8 @@ type Config struct {
 			WriteTimeout      time.Duration `json:"writeTimeout" yaml:"writeTimeout"`
 			IdleTimeout       time.Duration `json:"idleTimeout" yaml:"idleTimeout"`
 		} `json:"timeouts" yaml:"timeouts"`
+		// TrustedProxies 為反向代理的網段（CIDR），只採用來自這些位址的 X-Forwarded-For；未設定時以連線的來源位址為用戶端 IP
+		TrustedProxies []string `mapstructure:"trustedProxies" json:"trustedProxies" yaml:"trustedProxies"`
 	} `json:"http" yaml:"http"`
 
 	Observability struct {
@@ -62,13 +65,109 @@ type Config struct {
 	RPC struct {
 		Clients map[string]RPCClientConfig `mapstructure:"clients" json:"clients" yaml:"clients"`
 		Server  struct {
-			Target string `json:"target" yaml:"target"`
+			Target       string                `json:"target" yaml:"target"`
+			Interceptors RPCInterceptorsConfig `mapstructure:"interceptors" json:"interceptors" yaml:"interceptors"`
+			// HealthCheckInterval 為 grpc.health.v1 狀態依 Postgres 與 Redis 重新檢查的間隔，未設定時為 10s
+			HealthCheckInterval time.Duration      `mapstructure:"healthCheckInterval" json:"healthCheckInterval" yaml:"healthCheckInterval"`
+			Reflection          bool               `mapstructure:"reflection" json:"reflection" yaml:"reflection"` // 啟用 server reflection，供 grpcurl 等工具使用
+			TLS                 RPCServerTLSConfig `mapstructure:"tls" json:"tls" yaml:"tls"`
+			// TrustedForwarders 為可在請求中轉送用戶端 IP 與 User-Agent 的呼叫端網段（CIDR），例如 HTTP 閘道；
+			// 通過 mTLS 驗證的呼叫端一律信任，其餘呼叫端轉送的值不採用，以連線的來源位址為準
+			TrustedForwarders []string `mapstructure:"trustedForwarders" json:"trustedForwarders" yaml:"trustedForwarders"`
 		} `json:"server" yaml:"server"`
 	} `mapstructure:"rpc" json:"rpc" yaml:"rpc"`
 
 	Auth struct {
-		JWTSecret string `json:"jwtSecret" yaml:"jwtSecret"`
+		JWTSecret       string        `json:"jwtSecret" yaml:"jwtSecret"`
+		AccessTokenTTL  time.Duration `json:"accessTokenTTL" yaml:"accessTokenTTL"`   // access token 有效期，未設定時為 15 分鐘
+		RefreshTokenTTL time.Duration `json:"refreshTokenTTL" yaml:"refreshTokenTTL"` // refresh token 有效期，未設定時為 30 天
+		// SigningKeys 為非對稱簽章金鑰，由舊到新排列，設定後改以 RS256/EdDSA 簽發 token，JWTSecret 僅用於在 LegacyHS256Until 前驗證舊的 HS256 token
+		SigningKeys         []SigningKeyConfig `mapstructure:"signingKeys" json:"signingKeys" yaml:"signingKeys"`
+		KeyRotationInterval time.Duration      `json:"keyRotationInterval" yaml:"keyRotationInterval"` // 重新讀取 SigningKeys 既有路徑的 PEM 檔案的間隔，讓替換後的檔案無需重啟即可生效；新增金鑰需修改設定並重啟，可預先以 activeFrom 設定未來啟用的金鑰；未設定時不重新讀取
+		LocalVerification   bool               `json:"localVerification" yaml:"localVerification"`     // HTTP JWT 中間件於本地驗證 token，無法判斷時退回呼叫 ValidateToken；不檢查使用者狀態，依賴停權等操作發佈的工作階段撤銷事件
+		LegacyTokenKeys     bool               `json:"legacyTokenKeys" yaml:"legacyTokenKeys"`         // 遷移期間仍檢查以完整 token 為鍵的舊黑名單，待舊 token 全部過期後關閉
+
+		// LegacyHS256Until 為遷移期限（RFC 3339），期限前仍接受未帶 kid 的舊 HS256 token，以及設定 SigningKeys 前以 JWTSecret 簽發的 token；未設定時不接受
+		LegacyHS256Until time.Time `mapstructure:"legacyHS256Until" json:"legacyHS256Until" yaml:"legacyHS256Until"`
+
+		PasswordHashing struct {
+			Algorithm string `json:"algorithm" yaml:"algorithm"` // 可選: "argon2id", "bcrypt"，未設定時為 "argon2id"；登入時會將其他演算法或參數的雜湊值重新雜湊
+			Argon2id  struct {
+				Memory      uint32 `json:"memory" yaml:"memory"`           // 記憶體用量（KiB），未設定時為 65536
+				Iterations  uint32 `json:"iterations" yaml:"iterations"`   // 未設定時為 3
+				Parallelism uint8  `json:"parallelism" yaml:"parallelism"` // 未設定時為 4
+				SaltLength  uint32 `json:"saltLength" yaml:"saltLength"`   // 未設定時為 16
+				KeyLength   uint32 `json:"keyLength" yaml:"keyLength"`     // 未設定時為 32
+			} `json:"argon2id" yaml:"argon2id"`
+			BcryptCost int `json:"bcryptCost" yaml:"bcryptCost"` // 未設定時為 bcrypt.DefaultCost
+		} `json:"passwordHashing" yaml:"passwordHashing"`
+
+		PasswordPolicy struct {
+			MinLength        int      `json:"minLength" yaml:"minLength"`               // 以 Unicode 字元計算，未設定時為 8
+			MaxLength        int      `json:"maxLength" yaml:"maxLength"`               // 以 Unicode 字元計算，未設定時為 128
+			CharacterClasses []string `json:"characterClasses" yaml:"characterClasses"` // 必須包含的字元類別，可選: "upper", "lower", "digit", "symbol"；未設定時四種皆需包含，設為空陣列表示不限制
+			MaxRepeats       int      `json:"maxRepeats" yaml:"maxRepeats"`             // 同一字元連續出現的上限，未設定時不限制
+			DisallowedWords  []string `json:"disallowedWords" yaml:"disallowedWords"`   // 不得出現在密碼中的字詞，不分大小寫；使用者的 email 與名稱一律禁止
+
+			BreachedPasswords struct {
+				Path string `json:"path" yaml:"path"` // 外洩密碼 SHA-1 k-anonymity 前綴檔案（<PREFIX>.txt）所在的目錄，未設定時不檢查
+			} `json:"breachedPasswords" yaml:"breachedPasswords"`
+		} `json:"passwordPolicy" yaml:"passwordPolicy"`
+
+		PasswordReset struct {
+			TTL time.Duration `json:"ttl" yaml:"ttl"` // 重設密碼 token 有效期，未設定時為 30 分鐘
+			URL string        `json:"url" yaml:"url"` // 重設密碼頁面網址，token 以 query 參數附加於後
+		} `json:"passwordReset" yaml:"passwordReset"`
+
+		EmailVerification struct {
+			TTL time.Duration `json:"ttl" yaml:"ttl"` // email 驗證 token 有效期，未設定時為 24 小時
+			URL string        `json:"url" yaml:"url"` // email 驗證頁面網址，token 以 query 參數附加於後
+		} `json:"emailVerification" yaml:"emailVerification"`
+
+		MFA struct {
+			Issuer       string        `json:"issuer" yaml:"issuer"`             // 顯示於驗證器中的發行者名稱，未設定時使用 Env.ServiceName
+			ChallengeTTL time.Duration `json:"challengeTTL" yaml:"challengeTTL"` // 密碼驗證後等待 MFA 驗證的有效期，未設定時為 5 分鐘
+		} `json:"mfa" yaml:"mfa"`
+
+		BruteForce struct {
+			FailureWindow    time.Duration `json:"failureWindow" yaml:"failureWindow"`       // 登入失敗次數的統計期間，未設定時為 1 小時
+			FreeAttempts     int           `json:"freeAttempts" yaml:"freeAttempts"`         // 不延遲的失敗次數，未設定時為 3
+			BaseDelay        time.Duration `json:"baseDelay" yaml:"baseDelay"`               // 超過 FreeAttempts 後的第一次延遲，之後每次加倍，未設定時為 1 秒
+			MaxDelay         time.Duration `json:"maxDelay" yaml:"maxDelay"`                 // 延遲上限，未設定時為 5 分鐘
+			MaxEmailFailures int           `json:"maxEmailFailures" yaml:"maxEmailFailures"` // 同一帳號達到此失敗次數時鎖定，未設定時為 10
+			MaxIPFailures    int           `json:"maxIPFailures" yaml:"maxIPFailures"`       // 同一 IP 達到此失敗次數時鎖定，未設定時為 50
+			LockoutDuration  time.Duration `json:"lockoutDuration" yaml:"lockoutDuration"`   // 鎖定時間，未設定時為 30 分鐘
+		} `json:"bruteForce" yaml:"bruteForce"`
+
+		OAuth2 struct {
+			Issuer string `json:"issuer" yaml:"issuer"` // 授權伺服器對外的網址，寫入 token 的 iss 並作為 discovery 文件的 issuer，未設定時 token 不帶 iss
+		} `json:"oauth2" yaml:"oauth2"`
+
+		OIDC struct {
+			StateTTL  time.Duration                 `json:"stateTTL" yaml:"stateTTL"` // 導向提供者登入後需完成回呼的期限，未設定時為 10 分鐘
+			Providers map[string]OIDCProviderConfig `json:"providers" yaml:"providers"`
+		} `json:"oidc" yaml:"oidc"`
 	} `json:"auth" yaml:"auth"`
+
+	Notification struct {
+		Driver string `json:"driver" yaml:"driver"` // 可選: "log"，未設定時為 "log"
+	} `json:"notification" yaml:"notification"`
+
+	Audit struct {
+		Sinks []string `json:"sinks" yaml:"sinks"` // 稽核事件的次要輸出，可選: "log"；主要輸出固定為 Postgres 的 audit_events 表
+	} `json:"audit" yaml:"audit"`
+
+	RateLimit struct {
+		Enable bool `json:"enable" yaml:"enable"`
+		// Rules 為限流規則，一個請求可同時符合多條規則，任一條超過限制即拒絕
+		Rules []RateLimitRuleConfig `mapstructure:"rules" json:"rules" yaml:"rules"`
+	} `mapstructure:"rateLimit" json:"rateLimit" yaml:"rateLimit"`
+
+	Idempotency struct {
+		Enable      bool          `json:"enable" yaml:"enable"`
+		TTL         time.Duration `mapstructure:"ttl" json:"ttl" yaml:"ttl"`                         // 保存回應供重送請求重播的時間，未設定時為 24h
+		LockTimeout time.Duration `mapstructure:"lockTimeout" json:"lockTimeout" yaml:"lockTimeout"` // 同一 key 的請求處理中時，重複請求最多等待的時間，未設定時為 10s；處理期間會持續延長鎖
+	} `json:"idempotency" yaml:"idempotency"`
 }
 
 type Log struct {
@@ -79,8 +178,70 @@ type Log struct {
 	RotationTime time.Duration `json:"rotationTime" yaml:"rotationTime"`
 }
 
+// SigningKeyConfig 定義一把以 PEM 檔案存放的 JWT 簽章私鑰
+type SigningKeyConfig struct {
+	KID            string `mapstructure:"kid" json:"kid" yaml:"kid"`
+	PrivateKeyPath string `mapstructure:"privateKeyPath" json:"privateKeyPath" yaml:"privateKeyPath"` // PKCS#1/PKCS#8 RSA 或 PKCS#8 Ed25519 私鑰
+	// ActiveFrom 為開始用於簽章的時間（RFC 3339），之前僅出現在 JWKS 中供下游預先取得；未設定時立即生效
+	ActiveFrom time.Time `mapstructure:"activeFrom" json:"activeFrom" yaml:"activeFrom"`
+}
+
+// OIDCProviderConfig 定義一個 OIDC 身分提供者，端點由 Issuer 的 discovery 文件取得
+type OIDCProviderConfig struct {
+	Issuer       string   `mapstructure:"issuer" json:"issuer" yaml:"issuer"`
+	ClientID     string   `mapstructure:"clientID" json:"clientID" yaml:"clientID"`
+	ClientSecret string   `mapstructure:"clientSecret" json:"clientSecret" yaml:"clientSecret"` // 公開用戶端僅使用 PKCE 時可留空
+	RedirectURL  string   `mapstructure:"redirectURL" json:"redirectURL" yaml:"redirectURL"`    // 需指向 /auth/oidc/{provider}/callback
+	Scopes       []string `mapstructure:"scopes" json:"scopes" yaml:"scopes"`                   // 未設定時為 openid email profile
+}
+
+// RateLimitRuleConfig 定義一條 GCRA 限流規則：每 Period 可發出 Rate 次請求，短時間內最多連續 Burst 次
+type RateLimitRuleConfig struct {
+	Name string `mapstructure:"name" json:"name" yaml:"name"` // 規則名稱，作為 Redis 鍵的一部分，不可重複
+	// Routes 為套用的路由，HTTP 為 "METHOD /path"（echo 的路由樣式），gRPC 為完整方法名稱 "/auth.v1.Auth/Login"；
+	// 結尾為 * 時比對前綴，未設定時套用至 HTTP 與 gRPC 的所有請求
+	Routes []string      `mapstructure:"routes" json:"routes" yaml:"routes"`
+	Key    string        `mapstructure:"key" json:"key" yaml:"key"` // 限流對象，可選: "ip", "user", "api_key", "route"（同一路由的所有請求共用額度）
+	Rate   int           `mapstructure:"rate" json:"rate" yaml:"rate"`
+	Period time.Duration `mapstructure:"period" json:"period" yaml:"period"`
+	Burst  int           `mapstructure:"burst" json:"burst" yaml:"burst"` // 未設定時等於 Rate
+}
+
+// RPCInterceptorsConfig 控制 gRPC 伺服器的 interceptor，錯誤轉換、權限檢查與限流一律啟用
+type RPCInterceptorsConfig struct {
+	Recovery   bool `mapstructure:"recovery" json:"recovery" yaml:"recovery"`       // 將 handler 的 panic 轉為 INTERNAL 並記錄堆疊
+	AccessLog  bool `mapstructure:"accessLog" json:"accessLog" yaml:"accessLog"`    // 每次呼叫記錄一筆存取日誌
+	RequestID  bool `mapstructure:"requestID" json:"requestID" yaml:"requestID"`    // 沿用 x-request-id metadata，未帶上時產生新的 ID
+	Validation bool `mapstructure:"validation" json:"validation" yaml:"validation"` // 依 proto 欄位的 validate 選項檢查請求
+	// DefaultTimeout 為呼叫端未設定 deadline 時套用的逾時，MaxTimeout 為呼叫端 deadline 的上限，0 表示不套用
+	DefaultTimeout time.Duration `mapstructure:"defaultTimeout" json:"defaultTimeout" yaml:"defaultTimeout"`
+	MaxTimeout     time.Duration `mapstructure:"maxTimeout" json:"maxTimeout" yaml:"maxTimeout"`
+}
+
 type RPCClientConfig struct {
-	Target string `mapstructure:"target" json:"target" yaml:"target"`
+	Target string             `mapstructure:"target" json:"target" yaml:"target"`
+	TLS    RPCClientTLSConfig `mapstructure:"tls" json:"tls" yaml:"tls"`
+}
+
+// RPCServerTLSConfig 為 gRPC 伺服器的 TLS 設定，憑證檔案更新後會在下次交握時重新載入，不需重啟
+type RPCServerTLSConfig struct {
+	Enable   bool   `mapstructure:"enable" json:"enable" yaml:"enable"`
+	CertFile string `mapstructure:"certFile" json:"certFile" yaml:"certFile"`
+	KeyFile  string `mapstructure:"keyFile" json:"keyFile" yaml:"keyFile"`
+	// ClientCAFile 設定時啟用 mTLS，要求用戶端出示由此 CA bundle 簽發的憑證
+	ClientCAFile   string        `mapstructure:"clientCAFile" json:"clientCAFile" yaml:"clientCAFile"`
+	ReloadInterval time.Duration `mapstructure:"reloadInterval" json:"reloadInterval" yaml:"reloadInterval"` // 檢查憑證檔案是否更新的間隔，未設定時為 1m
+}
+
+// RPCClientTLSConfig 為 RPC 客戶端的 TLS 設定，憑證檔案更新後會在下次交握時重新載入，不需重啟
+type RPCClientTLSConfig struct {
+	Enable bool   `mapstructure:"enable" json:"enable" yaml:"enable"`
+	CAFile string `mapstructure:"caFile" json:"caFile" yaml:"caFile"` // 驗證伺服器憑證的 CA bundle，未設定時使用系統 CA
+	// CertFile 與 KeyFile 為 mTLS 的用戶端憑證，伺服器啟用 mTLS 時需設定
+	CertFile       string        `mapstructure:"certFile" json:"certFile" yaml:"certFile"`
+	KeyFile        string        `mapstructure:"keyFile" json:"keyFile" yaml:"keyFile"`
+	ServerName     string        `mapstructure:"serverName" json:"serverName" yaml:"serverName"`             // 驗證伺服器憑證時使用的名稱，設定 caFile 時必填，否則未設定時為 target 的主機名稱
+	ReloadInterval time.Duration `mapstructure:"reloadInterval" json:"reloadInterval" yaml:"reloadInterval"` // 檢查憑證檔案是否更新的間隔，未設定時為 1m
 }
 
 // LoadWithEnv is a loads .yaml files through viper.
@@ -107,7 +268,13 @@ func LoadWithEnv[T any](currEnv string, configPath ...string) (*T, error) {
 		return nil, fmt.Errorf("read %s config failed: %w", currEnv, err)
 	}
 
-	if err := configCtl.Unmarshal(cfg); err != nil {
+	// 除預設的 duration 與 slice 外，時間欄位接受 RFC 3339 字串
+	decodeHook := viper.DecodeHook(mapstructure.ComposeDecodeHookFunc(
+		mapstructure.StringToTimeDurationHookFunc(),
+		mapstructure.StringToSliceHookFunc(","),
+		mapstructure.StringToTimeHookFunc(time.RFC3339),
+	))
+	if err := configCtl.Unmarshal(cfg, decodeHook); err != nil {
 		return nil, fmt.Errorf("unmarshal %s config failed: %w", currEnv, err)
 	}
 
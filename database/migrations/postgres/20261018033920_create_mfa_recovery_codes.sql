@@ -0,0 +1,21 @@
+-- DO NOT EDIT THIS FILE!!!
+--
+-- Generate by https://github.com/yanun0323/gem
+
+-- +goose Up
+CREATE TABLE IF NOT EXISTS "mfa_recovery_codes" (
+  "id" UUID DEFAULT gen_random_uuid(),
+  "user_id" UUID NOT NULL,
+  "code_hash" VARCHAR(64) NOT NULL,
+  "used_at" TIMESTAMP WITH TIME ZONE NULL,
+  "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("id")
+);
+
+CREATE INDEX idx_mfa_recovery_codes_user_id ON "mfa_recovery_codes" ("user_id");
+
+-- +goose Down
+DROP TABLE IF EXISTS "mfa_recovery_codes";
+
+
+-- DO NOT EDIT THIS FILE!!!
\ No newline at end of file
@@ -0,0 +1,20 @@
+-- DO NOT EDIT THIS FILE!!!
+--
+-- Generate by https://github.com/yanun0323/gem
+
+-- +goose Up
+CREATE TABLE IF NOT EXISTS "mfa_settings" (
+  "user_id" UUID NOT NULL,
+  "secret" VARCHAR(64) NOT NULL,
+  "enabled" BOOLEAN NOT NULL DEFAULT false,
+  "enabled_at" TIMESTAMP WITH TIME ZONE NULL,
+  "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("user_id")
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS "mfa_settings";
+
+
+-- DO NOT EDIT THIS FILE!!!
\ No newline at end of file
@@ -0,0 +1,20 @@
+-- DO NOT EDIT THIS FILE!!!
+--
+-- Generate by https://github.com/yanun0323/gem
+
+-- +goose Up
+CREATE TABLE IF NOT EXISTS "permissions" (
+  "id" UUID DEFAULT gen_random_uuid(),
+  "name" VARCHAR(64) NOT NULL,
+  "description" VARCHAR(255) NOT NULL DEFAULT '',
+  "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("id")
+);
+
+CREATE UNIQUE INDEX idx_permissions_name ON "permissions" ("name");
+
+-- +goose Down
+DROP TABLE IF EXISTS "permissions";
+
+
+-- DO NOT EDIT THIS FILE!!!
\ No newline at end of file
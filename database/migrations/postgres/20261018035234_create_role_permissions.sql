@@ -0,0 +1,20 @@
+-- DO NOT EDIT THIS FILE!!!
+--
+-- Generate by https://github.com/yanun0323/gem
+
+-- +goose Up
+CREATE TABLE IF NOT EXISTS "role_permissions" (
+  "id" UUID DEFAULT gen_random_uuid(),
+  "role_id" UUID NOT NULL,
+  "permission_id" UUID NOT NULL,
+  PRIMARY KEY ("id")
+);
+
+CREATE INDEX idx_role_permissions_permission_id ON "role_permissions" ("permission_id");
+CREATE UNIQUE INDEX idx_role_permissions_role_id_permission_id ON "role_permissions" ("role_id", "permission_id");
+
+-- +goose Down
+DROP TABLE IF EXISTS "role_permissions";
+
+
+-- DO NOT EDIT THIS FILE!!!
\ No newline at end of file
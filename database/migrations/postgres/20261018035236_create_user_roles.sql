@@ -0,0 +1,21 @@
+-- DO NOT EDIT THIS FILE!!!
+--
+-- Generate by https://github.com/yanun0323/gem
+
+-- +goose Up
+CREATE TABLE IF NOT EXISTS "user_roles" (
+  "id" UUID DEFAULT gen_random_uuid(),
+  "user_id" UUID NOT NULL,
+  "role_id" UUID NOT NULL,
+  "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("id")
+);
+
+CREATE INDEX idx_user_roles_role_id ON "user_roles" ("role_id");
+CREATE UNIQUE INDEX idx_user_roles_user_id_role_id ON "user_roles" ("user_id", "role_id");
+
+-- +goose Down
+DROP TABLE IF EXISTS "user_roles";
+
+
+-- DO NOT EDIT THIS FILE!!!
\ No newline at end of file
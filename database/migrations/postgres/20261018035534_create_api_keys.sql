@@ -0,0 +1,27 @@
+-- DO NOT EDIT THIS FILE!!!
+--
+-- Generate by https://github.com/yanun0323/gem
+
+-- +goose Up
+CREATE TABLE IF NOT EXISTS "api_keys" (
+  "id" UUID DEFAULT gen_random_uuid(),
+  "user_id" UUID NOT NULL,
+  "name" VARCHAR(64) NOT NULL,
+  "prefix" VARCHAR(32) NOT NULL,
+  "secret_hash" VARCHAR(64) NOT NULL,
+  "scopes" VARCHAR(1024) NOT NULL DEFAULT '',
+  "expires_at" TIMESTAMP WITH TIME ZONE NULL,
+  "last_used_at" TIMESTAMP WITH TIME ZONE NULL,
+  "revoked_at" TIMESTAMP WITH TIME ZONE NULL,
+  "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("id")
+);
+
+CREATE INDEX idx_api_keys_user_id ON "api_keys" ("user_id");
+CREATE UNIQUE INDEX idx_api_keys_prefix ON "api_keys" ("prefix");
+
+-- +goose Down
+DROP TABLE IF EXISTS "api_keys";
+
+
+-- DO NOT EDIT THIS FILE!!!
\ No newline at end of file
@@ -0,0 +1,24 @@
+-- DO NOT EDIT THIS FILE!!!
+--
+-- Generate by https://github.com/yanun0323/gem
+
+-- +goose Up
+CREATE TABLE IF NOT EXISTS "user_identities" (
+  "id" UUID DEFAULT gen_random_uuid(),
+  "user_id" UUID NOT NULL,
+  "provider" VARCHAR(64) NOT NULL,
+  "subject" VARCHAR(255) NOT NULL,
+  "email" VARCHAR(255) NOT NULL DEFAULT '',
+  "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "last_login_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("id")
+);
+
+CREATE INDEX idx_user_identities_user_id ON "user_identities" ("user_id");
+CREATE UNIQUE INDEX idx_user_identities_provider_subject ON "user_identities" ("provider", "subject");
+
+-- +goose Down
+DROP TABLE IF EXISTS "user_identities";
+
+
+-- DO NOT EDIT THIS FILE!!!
\ No newline at end of file
@@ -0,0 +1,21 @@
+-- DO NOT EDIT THIS FILE!!!
+--
+-- Generate by https://github.com/yanun0323/gem
+
+-- +goose Up
+CREATE TABLE IF NOT EXISTS "oauth_clients" (
+  "id" UUID DEFAULT gen_random_uuid(),
+  "name" VARCHAR(64) NOT NULL,
+  "secret_hash" VARCHAR(64) NOT NULL,
+  "scopes" VARCHAR(1024) NOT NULL DEFAULT '',
+  "grant_types" VARCHAR(256) NOT NULL DEFAULT '',
+  "revoked_at" TIMESTAMP WITH TIME ZONE NULL,
+  "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("id")
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS "oauth_clients";
+
+
+-- DO NOT EDIT THIS FILE!!!
\ No newline at end of file
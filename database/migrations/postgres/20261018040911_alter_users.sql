@@ -0,0 +1,12 @@
+-- DO NOT EDIT THIS FILE!!!
+--
+-- Generate by https://github.com/yanun0323/gem
+
+-- +goose Up
+ALTER TABLE "users" ALTER COLUMN "password" TYPE VARCHAR(255), ALTER COLUMN "password" SET NOT NULL;
+
+-- +goose Down
+ALTER TABLE "users" ALTER COLUMN "password" TYPE VARCHAR(60), ALTER COLUMN "password" SET NOT NULL;
+
+
+-- DO NOT EDIT THIS FILE!!!
\ No newline at end of file
@@ -0,0 +1,12 @@
+-- DO NOT EDIT THIS FILE!!!
+--
+-- Generate by https://github.com/yanun0323/gem
+
+-- +goose Up
+ALTER TABLE "users" ADD COLUMN "pending_email" VARCHAR(255) NULL;
+
+-- +goose Down
+ALTER TABLE "users" DROP COLUMN "pending_email";
+
+
+-- DO NOT EDIT THIS FILE!!!
\ No newline at end of file
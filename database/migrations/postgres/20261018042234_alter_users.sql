@@ -0,0 +1,12 @@
+-- DO NOT EDIT THIS FILE!!!
+--
+-- Generate by https://github.com/yanun0323/gem
+
+-- +goose Up
+CREATE INDEX idx_users_created_at_id ON "users" ("created_at", "id");
+
+-- +goose Down
+DROP INDEX idx_users_created_at_id;
+
+
+-- DO NOT EDIT THIS FILE!!!
\ No newline at end of file
@@ -0,0 +1,28 @@
+-- DO NOT EDIT THIS FILE!!!
+--
+-- Generate by https://github.com/yanun0323/gem
+
+-- +goose Up
+CREATE TABLE IF NOT EXISTS "audit_events" (
+  "id" UUID NOT NULL,
+  "type" VARCHAR(64) NOT NULL,
+  "actor_id" VARCHAR(64) NOT NULL DEFAULT '',
+  "subject_id" VARCHAR(64) NOT NULL DEFAULT '',
+  "ip_address" VARCHAR(64) NOT NULL DEFAULT '',
+  "user_agent" TEXT NOT NULL DEFAULT '',
+  "outcome" VARCHAR(16) NOT NULL,
+  "reason" TEXT NOT NULL DEFAULT '',
+  "trace_id" VARCHAR(32) NOT NULL DEFAULT '',
+  "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("id")
+);
+
+CREATE INDEX idx_audit_events_actor_id ON "audit_events" ("actor_id");
+CREATE INDEX idx_audit_events_created_at ON "audit_events" ("created_at");
+CREATE INDEX idx_audit_events_subject_id ON "audit_events" ("subject_id");
+
+-- +goose Down
+DROP TABLE IF EXISTS "audit_events";
+
+
+-- DO NOT EDIT THIS FILE!!!
\ No newline at end of file
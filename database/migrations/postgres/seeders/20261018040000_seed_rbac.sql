@@ -0,0 +1,26 @@
+-- +goose Up
+INSERT INTO "permissions" ("name", "description") VALUES
+  ('users:read', 'Read user accounts'),
+  ('users:write', 'Modify user accounts'),
+  ('roles:manage', 'Grant and revoke roles')
+ON CONFLICT ("name") DO NOTHING;
+
+INSERT INTO "roles" ("name", "description") VALUES
+  ('admin', 'Full administrative access')
+ON CONFLICT ("name") DO NOTHING;
+
+INSERT INTO "role_permissions" ("role_id", "permission_id")
+SELECT r."id", p."id"
+FROM "roles" r
+CROSS JOIN "permissions" p
+WHERE r."name" = 'admin'
+  AND p."name" IN ('users:read', 'users:write', 'roles:manage')
+ON CONFLICT ("role_id", "permission_id") DO NOTHING;
+
+-- +goose Down
+DELETE FROM "role_permissions"
+WHERE "role_id" IN (SELECT "id" FROM "roles" WHERE "name" = 'admin');
+
+DELETE FROM "roles" WHERE "name" = 'admin';
+
+DELETE FROM "permissions" WHERE "name" IN ('users:read', 'users:write', 'roles:manage');
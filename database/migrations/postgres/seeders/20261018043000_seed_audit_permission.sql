@@ -0,0 +1,18 @@
+-- +goose Up
+INSERT INTO "permissions" ("name", "description") VALUES
+  ('audit:read', 'Read security audit events')
+ON CONFLICT ("name") DO NOTHING;
+
+INSERT INTO "role_permissions" ("role_id", "permission_id")
+SELECT r."id", p."id"
+FROM "roles" r
+CROSS JOIN "permissions" p
+WHERE r."name" = 'admin'
+  AND p."name" = 'audit:read'
+ON CONFLICT ("role_id", "permission_id") DO NOTHING;
+
+-- +goose Down
+DELETE FROM "role_permissions"
+WHERE "permission_id" IN (SELECT "id" FROM "permissions" WHERE "name" = 'audit:read');
+
+DELETE FROM "permissions" WHERE "name" = 'audit:read';
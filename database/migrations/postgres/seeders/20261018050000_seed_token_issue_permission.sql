@@ -0,0 +1,11 @@
+-- +goose Up
+-- tokens:issue 只應授予內部服務，不加入 admin 角色
+INSERT INTO "permissions" ("name", "description") VALUES
+  ('tokens:issue', 'Issue tokens for any user')
+ON CONFLICT ("name") DO NOTHING;
+
+-- +goose Down
+DELETE FROM "role_permissions"
+WHERE "permission_id" IN (SELECT "id" FROM "permissions" WHERE "name" = 'tokens:issue');
+
+DELETE FROM "permissions" WHERE "name" = 'tokens:issue';
@@ -0,0 +1,154 @@
+package grpc
+
+import (
+	"context"
+
+	"server-template/internal/domain/entity"
+	userstatus "server-template/internal/domain/entity/user"
+	"server-template/internal/domain/errs"
+	"server-template/internal/domain/usecase"
+	"server-template/proto/pb/adminpb"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// adminServer 實作 Admin 服務，與 Auth 服務共用同一個 gRPC server 以結束使用者的工作階段
+type adminServer struct {
+	adminpb.UnimplementedAdminServer
+	admin  usecase.AdminUseCase
+	server *gRPCServer
+}
+
+func (s *adminServer) ListUsers(ctx context.Context, in *adminpb.ListUsersRequest) (*adminpb.ListUsersResponse, error) {
+	filter, err := newUserFilter(in)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.admin.ListUsers(ctx, filter, in.GetPageToken(), int(in.GetPageSize()))
+	if err != nil {
+		return nil, errors.Wrap(err, "admin.ListUsers")
+	}
+
+	users := make([]*adminpb.User, 0, len(page.Users))
+	for _, user := range page.Users {
+		users = append(users, newAdminUser(user))
+	}
+
+	resp := new(adminpb.ListUsersResponse)
+	resp.SetStatus(newStatus(codes.OK, "Users retrieved"))
+	resp.SetUsers(users)
+	resp.SetNextPageToken(page.NextCursor)
+	resp.SetTotalCount(page.Total)
+
+	return resp, nil
+}
+
+func (s *adminServer) GetUser(ctx context.Context, in *adminpb.GetUserRequest) (*adminpb.GetUserResponse, error) {
+	user, err := s.admin.GetUser(ctx, in.GetUserId())
+	if err != nil {
+		return nil, errors.Wrap(err, "admin.GetUser")
+	}
+
+	resp := new(adminpb.GetUserResponse)
+	resp.SetStatus(newStatus(codes.OK, "User retrieved"))
+	resp.SetUser(newAdminUser(user))
+
+	return resp, nil
+}
+
+func (s *adminServer) SuspendUser(ctx context.Context, in *adminpb.SuspendUserRequest) (*adminpb.SuspendUserResponse, error) {
+	user, err := s.admin.SuspendUser(ctx, in.GetUserId())
+	if err != nil {
+		return nil, errors.Wrap(err, "admin.SuspendUser")
+	}
+
+	// 停權後立即結束使用者所有的工作階段，使已發出的 token 失效
+	revoked, err := s.server.revokeAllSessions(ctx, user.ID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(adminpb.SuspendUserResponse)
+	resp.SetStatus(newStatus(codes.OK, "User suspended"))
+	resp.SetUser(newAdminUser(user))
+	resp.SetRevokedSessions(revoked)
+
+	return resp, nil
+}
+
+func (s *adminServer) ReactivateUser(ctx context.Context, in *adminpb.ReactivateUserRequest) (*adminpb.ReactivateUserResponse, error) {
+	user, err := s.admin.ReactivateUser(ctx, in.GetUserId())
+	if err != nil {
+		return nil, errors.Wrap(err, "admin.ReactivateUser")
+	}
+
+	resp := new(adminpb.ReactivateUserResponse)
+	resp.SetStatus(newStatus(codes.OK, "User reactivated"))
+	resp.SetUser(newAdminUser(user))
+
+	return resp, nil
+}
+
+func (s *adminServer) LogoutUser(ctx context.Context, in *adminpb.LogoutUserRequest) (*adminpb.LogoutUserResponse, error) {
+	// 先確認使用者存在，避免對不存在的使用者回傳成功
+	user, err := s.admin.GetUser(ctx, in.GetUserId())
+	if err != nil {
+		return nil, errors.Wrap(err, "admin.GetUser")
+	}
+
+	revoked, err := s.server.revokeAllSessions(ctx, user.ID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	s.server.recordEvent(ctx, entity.AuditEventSessionRevoke, "", user.ID)
+
+	resp := new(adminpb.LogoutUserResponse)
+	resp.SetStatus(newStatus(codes.OK, "User logged out"))
+	resp.SetRevokedSessions(revoked)
+
+	return resp, nil
+}
+
+// newUserFilter 將列出使用者的請求轉換為篩選條件
+func newUserFilter(in *adminpb.ListUsersRequest) (entity.UserFilter, error) {
+	filter := entity.UserFilter{EmailPrefix: in.GetEmailPrefix()}
+
+	if in.GetStatus() != "" {
+		status, err := userstatus.ParseUserStatus(in.GetStatus())
+		if err != nil {
+			return filter, errs.FieldError("status", err)
+		}
+		filter.Status = status
+	}
+	if in.HasCreatedAfter() {
+		createdAfter := in.GetCreatedAfter().AsTime()
+		filter.CreatedAfter = &createdAfter
+	}
+	if in.HasCreatedBefore() {
+		createdBefore := in.GetCreatedBefore().AsTime()
+		filter.CreatedBefore = &createdBefore
+	}
+
+	return filter, nil
+}
+
+// newAdminUser 將使用者實體轉換為管理介面的 protobuf 訊息
+func newAdminUser(user *entity.User) *adminpb.User {
+	pbUser := new(adminpb.User)
+	pbUser.SetId(user.ID)
+	pbUser.SetName(user.Name)
+	pbUser.SetEmail(user.Email)
+	if user.PendingEmail != nil {
+		pbUser.SetPendingEmail(*user.PendingEmail)
+	}
+	pbUser.SetStatus(user.Status.String())
+	pbUser.SetMfaEnabled(user.MFAEnabled())
+	pbUser.SetCreatedAt(timestamppb.New(user.CreatedAt))
+	pbUser.SetUpdatedAt(timestamppb.New(user.UpdatedAt))
+
+	return pbUser
+}
@@ -0,0 +1,152 @@
+package grpc
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"server-template/config"
+	"server-template/internal/domain/entity"
+	userstatus "server-template/internal/domain/entity/user"
+	"server-template/internal/domain/repository"
+	"server-template/internal/domain/usecase"
+	"server-template/internal/infrastructure/redistest"
+	"server-template/internal/infrastructure/revocation"
+	"server-template/proto/pb/adminpb"
+
+	"go.uber.org/fx/fxtest"
+)
+
+// fakeAdminUseCase 只實作停權與抹除，回傳狀態已變更的使用者
+type fakeAdminUseCase struct {
+	usecase.AdminUseCase
+}
+
+func (fakeAdminUseCase) SuspendUser(_ context.Context, userID string) (*entity.User, error) {
+	return &entity.User{ID: userID, Status: userstatus.UserStatusSuspended}, nil
+}
+
+func (fakeAdminUseCase) EraseUser(_ context.Context, userID string) (*entity.User, error) {
+	return &entity.User{ID: userID, Email: entity.ErasedEmail(userID), Status: userstatus.UserStatusDeleted}, nil
+}
+
+// fakeSessionRepository 為記憶體中的工作階段
+type fakeSessionRepository struct {
+	repository.SessionRepository
+
+	mu       sync.Mutex
+	sessions map[string]*entity.Session
+}
+
+func (r *fakeSessionRepository) ListByUserID(_ context.Context, userID string) ([]*entity.Session, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var sessions []*entity.Session
+	for _, session := range r.sessions {
+		if session.UserID == userID {
+			sessions = append(sessions, session)
+		}
+	}
+
+	return sessions, nil
+}
+
+func (r *fakeSessionRepository) Delete(_ context.Context, _, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.sessions, id)
+
+	return nil
+}
+
+// TestAdminRevokesSessionsForLocalVerification 確認停權與抹除會發佈工作階段撤銷事件，
+// HTTP 本地驗證不檢查使用者狀態，只能依這些事件拒絕已發出的 token
+func TestAdminRevokesSessionsForLocalVerification(t *testing.T) {
+	tests := []struct {
+		name  string
+		apply func(context.Context, *adminServer, string) error
+	}{
+		{
+			name: "suspend",
+			apply: func(ctx context.Context, s *adminServer, userID string) error {
+				req := &adminpb.SuspendUserRequest{}
+				req.SetUserId(userID)
+				_, err := s.SuspendUser(ctx, req)
+
+				return err
+			},
+		},
+		{
+			name: "erase",
+			apply: func(ctx context.Context, s *adminServer, userID string) error {
+				req := &adminpb.EraseUserRequest{}
+				req.SetUserId(userID)
+				_, err := s.EraseUser(ctx, req)
+
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+			client := redistest.NewServer(t).Client(t)
+
+			// HTTP 節點的撤銷快取在訂閱後等待一個 access token 有效期才可信任，測試中不需等待
+			httpConfig := new(config.Config)
+			httpConfig.Auth.LocalVerification = true
+			httpConfig.Auth.AccessTokenTTL = time.Nanosecond
+
+			lc := fxtest.NewLifecycle(t)
+			revocations := revocation.New(revocation.Params{Lifecycle: lc, Config: httpConfig, Logger: logger, Redis: client})
+			lc.RequireStart()
+			t.Cleanup(lc.RequireStop)
+
+			deadline := time.Now().Add(5 * time.Second)
+			for !revocations.Ready() {
+				if time.Now().After(deadline) {
+					t.Fatal("revocation cache did not become ready")
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+
+			sessions := &fakeSessionRepository{sessions: map[string]*entity.Session{
+				"session-1": {ID: "session-1", UserID: "user-1"},
+				"session-2": {ID: "session-2", UserID: "user-1"},
+				"session-3": {ID: "session-3", UserID: "user-2"},
+			}}
+			server := &adminServer{
+				admin: fakeAdminUseCase{},
+				server: &gRPCServer{
+					cfg:      new(config.Config),
+					logger:   logger,
+					redis:    client,
+					sessions: sessions,
+				},
+			}
+
+			if err := tt.apply(context.Background(), server, "user-1"); err != nil {
+				t.Fatal(err)
+			}
+
+			for _, sessionID := range []string{"session-1", "session-2"} {
+				deadline := time.Now().Add(5 * time.Second)
+				for !revocations.IsRevoked(revocation.SessionID(sessionID)) {
+					if time.Now().After(deadline) {
+						t.Fatalf("%s was not revoked in the local revocation cache", sessionID)
+					}
+					time.Sleep(10 * time.Millisecond)
+				}
+			}
+			if revocations.IsRevoked(revocation.SessionID("session-3")) {
+				t.Error("another user's session was revoked")
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"server-template/internal/domain/entity"
+	"server-template/proto/pb/authpb"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *gRPCServer) CreateAPIKey(ctx context.Context, in *authpb.CreateAPIKeyRequest) (*authpb.CreateAPIKeyResponse, error) {
+	var expiresAt *time.Time
+	if in.HasExpiresAt() {
+		t := in.GetExpiresAt().AsTime()
+		expiresAt = &t
+	}
+
+	// permissionInterceptor 已驗證呼叫者，金鑰的 scopes 不得超出呼叫者 token 帶有的權限
+	caller := ctx.Value(claimsContextKey{}).(*entity.Claims)
+	key, rawKey, err := s.auth.CreateAPIKey(ctx, in.GetUserId(), in.GetName(), in.GetScopes(), expiresAt, caller.Permissions())
+	if err != nil {
+		return nil, errors.Wrap(err, "auth.CreateAPIKey")
+	}
+
+	resp := new(authpb.CreateAPIKeyResponse)
+	resp.SetStatus(newStatus(codes.OK, "API key created, store it securely as it will not be shown again"))
+	resp.SetApiKey(newAPIKey(key))
+	resp.SetKey(rawKey)
+
+	return resp, nil
+}
+
+func (s *gRPCServer) ListAPIKeys(ctx context.Context, in *authpb.ListAPIKeysRequest) (*authpb.ListAPIKeysResponse, error) {
+	keys, err := s.auth.ListAPIKeys(ctx, in.GetUserId())
+	if err != nil {
+		return nil, errors.Wrap(err, "auth.ListAPIKeys")
+	}
+
+	pbKeys := make([]*authpb.APIKey, 0, len(keys))
+	for _, key := range keys {
+		pbKeys = append(pbKeys, newAPIKey(key))
+	}
+
+	resp := new(authpb.ListAPIKeysResponse)
+	resp.SetStatus(newStatus(codes.OK, "API keys listed successfully"))
+	resp.SetApiKeys(pbKeys)
+
+	return resp, nil
+}
+
+func (s *gRPCServer) RevokeAPIKey(ctx context.Context, in *authpb.RevokeAPIKeyRequest) (*authpb.RevokeAPIKeyResponse, error) {
+	if err := s.auth.RevokeAPIKey(ctx, in.GetUserId(), in.GetId()); err != nil {
+		return nil, errors.Wrap(err, "auth.RevokeAPIKey")
+	}
+
+	resp := new(authpb.RevokeAPIKeyResponse)
+	resp.SetStatus(newStatus(codes.OK, "API key revoked successfully"))
+
+	return resp, nil
+}
+
+func (s *gRPCServer) ValidateAPIKey(ctx context.Context, in *authpb.ValidateAPIKeyRequest) (*authpb.ValidateAPIKeyResponse, error) {
+	principal, err := s.auth.AuthenticateAPIKey(ctx, in.GetKey())
+	if err != nil {
+		return nil, errors.Wrap(err, "auth.AuthenticateAPIKey")
+	}
+
+	resp := new(authpb.ValidateAPIKeyResponse)
+	resp.SetStatus(newStatus(codes.OK, "API key is valid"))
+	resp.SetUser(newUser(principal.User))
+	resp.SetApiKeyId(principal.Key.ID)
+	resp.SetPermissions(principal.Permissions)
+
+	return resp, nil
+}
+
+// apiKeyClaims 驗證 API key 並將呼叫者轉換為與 access token 相同形式的聲明
+func (s *gRPCServer) apiKeyClaims(ctx context.Context, rawKey string) (*entity.Claims, error) {
+	principal, err := s.auth.AuthenticateAPIKey(ctx, rawKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "auth.AuthenticateAPIKey")
+	}
+
+	return &entity.Claims{
+		UserID:   principal.User.ID,
+		Email:    principal.User.Email,
+		Scope:    strings.Join(principal.Permissions, " "),
+		APIKeyID: principal.Key.ID,
+	}, nil
+}
+
+// newAPIKey 將 API key 實體轉換為 protobuf 訊息
+func newAPIKey(key *entity.APIKey) *authpb.APIKey {
+	pbKey := new(authpb.APIKey)
+	pbKey.SetId(key.ID)
+	pbKey.SetName(key.Name)
+	pbKey.SetPrefix(key.Prefix)
+	pbKey.SetScopes(key.ScopeList())
+	pbKey.SetCreatedAt(timestamppb.New(key.CreatedAt))
+	if key.ExpiresAt != nil {
+		pbKey.SetExpiresAt(timestamppb.New(*key.ExpiresAt))
+	}
+	if key.LastUsedAt != nil {
+		pbKey.SetLastUsedAt(timestamppb.New(*key.LastUsedAt))
+	}
+
+	return pbKey
+}
@@ -0,0 +1,68 @@
+package grpc
+
+import (
+	"context"
+
+	"server-template/internal/domain/entity"
+	"server-template/proto/pb/adminpb"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *adminServer) ListAuditEvents(ctx context.Context, in *adminpb.ListAuditEventsRequest) (*adminpb.ListAuditEventsResponse, error) {
+	filter := entity.AuditEventFilter{UserID: in.GetUserId()}
+	if in.HasCreatedAfter() {
+		createdAfter := in.GetCreatedAfter().AsTime()
+		filter.CreatedAfter = &createdAfter
+	}
+	if in.HasCreatedBefore() {
+		createdBefore := in.GetCreatedBefore().AsTime()
+		filter.CreatedBefore = &createdBefore
+	}
+
+	page, err := s.admin.ListAuditEvents(ctx, filter, in.GetPageToken(), int(in.GetPageSize()))
+	if err != nil {
+		return nil, errors.Wrap(err, "admin.ListAuditEvents")
+	}
+
+	events := make([]*adminpb.AuditEvent, 0, len(page.Events))
+	for _, event := range page.Events {
+		events = append(events, newAuditEvent(event))
+	}
+
+	resp := new(adminpb.ListAuditEventsResponse)
+	resp.SetStatus(newStatus(codes.OK, "Audit events retrieved"))
+	resp.SetEvents(events)
+	resp.SetNextPageToken(page.NextCursor)
+
+	return resp, nil
+}
+
+// recordEvent 記錄成功的安全事件，actorID 為空時由 ctx 中的呼叫端身分補上
+func (s *gRPCServer) recordEvent(ctx context.Context, eventType entity.AuditEventType, actorID, subjectID string) {
+	s.audit.Record(ctx, &entity.AuditEvent{
+		Type:      eventType,
+		ActorID:   actorID,
+		SubjectID: subjectID,
+		Outcome:   entity.AuditOutcomeSuccess,
+	})
+}
+
+// newAuditEvent 將稽核事件實體轉換為 protobuf 訊息
+func newAuditEvent(event *entity.AuditEvent) *adminpb.AuditEvent {
+	pbEvent := new(adminpb.AuditEvent)
+	pbEvent.SetId(event.ID)
+	pbEvent.SetType(string(event.Type))
+	pbEvent.SetActorId(event.ActorID)
+	pbEvent.SetSubjectId(event.SubjectID)
+	pbEvent.SetIpAddress(event.IPAddress)
+	pbEvent.SetUserAgent(event.UserAgent)
+	pbEvent.SetOutcome(string(event.Outcome))
+	pbEvent.SetReason(event.Reason)
+	pbEvent.SetTraceId(event.TraceID)
+	pbEvent.SetCreatedAt(timestamppb.New(event.CreatedAt))
+
+	return pbEvent
+}
@@ -0,0 +1,54 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+
+	"server-template/proto/pb/adminpb"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+)
+
+func (s *adminServer) ExportUser(ctx context.Context, in *adminpb.ExportUserRequest) (*adminpb.ExportUserResponse, error) {
+	export, err := s.admin.ExportUser(ctx, in.GetUserId())
+	if err != nil {
+		return nil, errors.Wrap(err, "admin.ExportUser")
+	}
+
+	archive, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal user export")
+	}
+
+	resp := new(adminpb.ExportUserResponse)
+	resp.SetStatus(newStatus(codes.OK, "User exported"))
+	resp.SetArchive(archive)
+
+	return resp, nil
+}
+
+func (s *adminServer) EraseUser(ctx context.Context, in *adminpb.EraseUserRequest) (*adminpb.EraseUserResponse, error) {
+	user, err := s.admin.EraseUser(ctx, in.GetUserId())
+	if err != nil {
+		return nil, errors.Wrap(err, "admin.EraseUser")
+	}
+
+	// 結束使用者所有的工作階段並刪除其 refresh token family
+	revoked, err := s.server.revokeAllSessions(ctx, user.ID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// refresh token 記錄含有使用者的 email，連同 access token 記錄一併刪除
+	if err := s.server.deleteOwnerTokens(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	resp := new(adminpb.EraseUserResponse)
+	resp.SetStatus(newStatus(codes.OK, "User erased"))
+	resp.SetUser(newAdminUser(user))
+	resp.SetRevokedSessions(revoked)
+
+	return resp, nil
+}
@@ -8,49 +8,110 @@ import (
 	"time"
 
 	"server-template/config"
+	"server-template/internal/domain/audit"
 	"server-template/internal/domain/delivery"
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/errs"
+	"server-template/internal/domain/repository"
 	"server-template/internal/domain/usecase"
+	"server-template/internal/infrastructure/health"
+	"server-template/internal/infrastructure/jwtkey"
+	"server-template/internal/infrastructure/ratelimit"
+	"server-template/internal/infrastructure/revocation"
+	"server-template/internal/infrastructure/rpc"
+	"server-template/proto/pb/adminpb"
 	"server-template/proto/pb/authpb"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/redis/go-redis/v9"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.uber.org/fx"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type gRPCServer struct {
 	authpb.UnimplementedAuthServer
+	audit      audit.Recorder
 	auth       usecase.AuthUseCase
 	cfg        *config.Config
 	grpcServer *grpc.Server
+	keys       *jwtkey.KeySet
+	limiter    *ratelimit.Limiter
 	logger     *slog.Logger
 	redis      *redis.ClusterClient
+	sessions   repository.SessionRepository
+	// trustedForwarders 為可轉送用戶端資訊的呼叫端網段
+	trustedForwarders []*net.IPNet
 }
 
-func NewGRPC(lc fx.Lifecycle, auth usecase.AuthUseCase, cfg *config.Config, logger *slog.Logger, redis *redis.ClusterClient) (delivery.Delivery, error) {
-	var opts []grpc.ServerOption
+func NewGRPC(lc fx.Lifecycle, audit audit.Recorder, auth usecase.AuthUseCase, admin usecase.AdminUseCase, cfg *config.Config, checker *health.Checker, keys *jwtkey.KeySet, limiter *ratelimit.Limiter, logger *slog.Logger, redis *redis.ClusterClient, sessions repository.SessionRepository) (delivery.Delivery, error) {
+	server := &gRPCServer{
+		audit:    audit,
+		auth:     auth,
+		cfg:      cfg,
+		keys:     keys,
+		limiter:  limiter,
+		logger:   logger,
+		redis:    redis,
+		sessions: sessions,
+	}
+
+	for _, cidr := range cfg.RPC.Server.TrustedForwarders {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid trusted forwarder %q", cidr)
+		}
+		server.trustedForwarders = append(server.trustedForwarders, ipNet)
+	}
+
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(server.unaryInterceptors()...),
+		grpc.ChainStreamInterceptor(server.streamInterceptors()...),
+	}
 	if cfg.Observability.Otel.Enable {
 		opts = append(opts, grpc.StatsHandler(otelgrpc.NewServerHandler()))
 	}
-	grpcServer := grpc.NewServer(opts...)
 
-	server := &gRPCServer{
-		auth:       auth,
-		cfg:        cfg,
-		grpcServer: grpcServer,
-		logger:     logger,
-		redis:      redis,
+	creds, err := rpc.ServerCredentials(cfg.RPC.Server.TLS, logger)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create gRPC server credentials")
+	}
+	if creds != nil {
+		opts = append(opts, grpc.Creds(creds))
 	}
+	grpcServer := grpc.NewServer(opts...)
+	server.grpcServer = grpcServer
 
 	authpb.RegisterAuthServer(grpcServer, server)
+	adminpb.RegisterAdminServer(grpcServer, &adminServer{admin: admin, server: server})
+
+	healthServer := grpchealth.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	if cfg.RPC.Server.Reflection {
+		reflection.Register(grpcServer)
+	}
 
+	reporter := newHealthReporter(checker, healthServer, logger, cfg.RPC.Server.HealthCheckInterval)
+	healthCtx, stopHealth := context.WithCancel(context.Background())
 	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			reporter.update(ctx)
+			go reporter.run(healthCtx)
+
+			return nil
+		},
 		OnStop: func(ctx context.Context) error {
 			slog.Info("Stopping gRPC server")
+			// 先回報 NOT_SERVING，讓負載平衡器在連線排空期間不再送入新的請求
+			healthServer.Shutdown()
+			stopHealth()
 			grpcServer.GracefulStop()
 
 			return nil
@@ -79,91 +140,158 @@ func (s *gRPCServer) Serve(ctx context.Context) error {
 }
 
 func (s *gRPCServer) Register(ctx context.Context, in *authpb.RegisterRequest) (*authpb.RegisterResponse, error) {
-	user, err := s.auth.Register(ctx, in.GetEmail(), in.GetPassword())
+	user, err := s.auth.Register(ctx, in.GetEmail(), in.GetPassword(), in.GetName())
 	if err != nil {
 		return nil, errors.Wrap(err, "auth.Register")
 	}
 
 	resp := new(authpb.RegisterResponse)
-	resp.GetStatus().SetCode(int32(codes.OK))
-	resp.GetStatus().SetMessage("Register successful")
-	resp.GetUser().SetId(user.ID)
-	resp.GetUser().SetEmail(user.Email)
-	resp.GetUser().SetCreatedAt(timestamppb.New(user.CreatedAt))
+	resp.SetStatus(newStatus(codes.OK, "Register successful"))
+	resp.SetUser(newUser(user))
 
 	return resp, nil
 }
 
 func (s *gRPCServer) Login(ctx context.Context, in *authpb.LoginRequest) (*authpb.LoginResponse, error) {
-	user, err := s.auth.Login(ctx, in.GetEmail(), in.GetPassword())
+	client := audit.ClientFromContext(ctx)
+	user, err := s.auth.Login(ctx, in.GetEmail(), in.GetPassword(), client)
 	if err != nil {
 		return nil, errors.Wrap(err, "auth.Login")
 	}
 
-	// 生成 token
-	token, err := s.generateToken(ctx, user.ID, user.Email)
+	return s.loginResponse(ctx, user, client)
+}
+
+// loginResponse 為通過驗證的使用者建立工作階段並發放 token，已啟用 MFA 的使用者則改為建立 MFA challenge
+func (s *gRPCServer) loginResponse(ctx context.Context, user *entity.User, client entity.ClientInfo) (*authpb.LoginResponse, error) {
+	// 已啟用 MFA 的使用者需先通過 VerifyMFA 才會取得 token
+	if user.MFAEnabled() {
+		challengeID, err := s.auth.CreateMFAChallenge(ctx, user.ID)
+		if err != nil {
+			return nil, errors.Wrap(err, "auth.CreateMFAChallenge")
+		}
+
+		resp := new(authpb.LoginResponse)
+		resp.SetStatus(newStatus(codes.OK, "MFA verification required"))
+		resp.SetMfaRequired(true)
+		resp.SetMfaChallengeId(challengeID)
+
+		return resp, nil
+	}
+
+	// 建立工作階段並生成 access token 與 refresh token
+	token, refreshToken, err := s.issueTokenPair(ctx, user.ID, user.Email, client)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to generate token")
 	}
+	s.recordEvent(audit.WithClient(ctx, client), entity.AuditEventLogin, user.ID, user.ID)
 
 	resp := new(authpb.LoginResponse)
-	resp.GetStatus().SetCode(int32(codes.OK))
-	resp.GetStatus().SetMessage("Login successful")
-	resp.GetUser().SetId(user.ID)
-	resp.GetUser().SetEmail(user.Email)
-	resp.GetUser().SetCreatedAt(timestamppb.New(user.CreatedAt))
+	resp.SetStatus(newStatus(codes.OK, "Login successful"))
+	resp.SetUser(newUser(user))
 	resp.SetToken(token)
+	resp.SetRefreshToken(refreshToken)
 
 	return resp, nil
 }
 
 func (s *gRPCServer) Logout(ctx context.Context, in *authpb.LogoutRequest) (*authpb.LogoutResponse, error) {
 	// 將 token 加入黑名單
-	err := s.invalidateToken(ctx, in.GetToken())
+	claims, err := s.invalidateToken(ctx, in.GetToken())
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to invalidate token")
 	}
 
+	// 結束 token 所屬的工作階段
+	if claims.SessionID != "" {
+		if err := s.revokeSession(ctx, claims.UserID, claims.SessionID); err != nil {
+			return nil, errors.Wrap(err, "failed to revoke session")
+		}
+	}
+
+	// 同時撤銷 refresh token 所屬的工作階段
+	if in.GetRefreshToken() != "" {
+		if err := s.revokeRefreshToken(ctx, in.GetRefreshToken()); err != nil {
+			return nil, errors.Wrap(err, "failed to revoke refresh token")
+		}
+	}
+	s.recordEvent(ctx, entity.AuditEventLogout, claims.UserID, claims.UserID)
+
 	resp := new(authpb.LogoutResponse)
-	resp.GetStatus().SetCode(int32(codes.OK))
-	resp.GetStatus().SetMessage("Logout successful")
+	resp.SetStatus(newStatus(codes.OK, "Logout successful"))
 
 	return resp, nil
 }
 
 func (s *gRPCServer) GenerateToken(ctx context.Context, in *authpb.GenerateTokenRequest) (*authpb.GenerateTokenResponse, error) {
-	token, err := s.generateToken(ctx, in.GetUserId(), in.GetEmail())
+	user, err := s.auth.GetUserByID(ctx, in.GetUserId())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user")
+	}
+
+	if !user.IsActive() {
+		resp := new(authpb.GenerateTokenResponse)
+		resp.SetStatus(newStatus(codes.FailedPrecondition, "User is not active"))
+
+		return resp, nil
+	}
+
+	client := audit.ClientFromContext(ctx)
+	// email 聲明一律取自使用者資料，避免與 token 的主體不一致
+	token, refreshToken, err := s.issueTokenPair(ctx, user.ID, user.Email, client)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to generate token")
 	}
 
 	resp := new(authpb.GenerateTokenResponse)
-	resp.GetStatus().SetCode(int32(codes.OK))
-	resp.GetStatus().SetMessage("Token generated successfully")
+	resp.SetStatus(newStatus(codes.OK, "Token generated successfully"))
 	resp.SetToken(token)
+	resp.SetRefreshToken(refreshToken)
 
 	return resp, nil
 }
 
 func (s *gRPCServer) ValidateToken(ctx context.Context, in *authpb.ValidateTokenRequest) (*authpb.ValidateTokenResponse, error) {
+	// 解析 token
+	claims, err := s.parseToken(in.GetToken())
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid token")
+	}
+
 	// 檢查 token 是否在黑名單中
-	isInvalid, err := s.isTokenInvalid(ctx, in.GetToken())
+	isInvalid, err := s.isTokenInvalid(ctx, claims, in.GetToken())
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to check token validity")
 	}
 
 	if isInvalid {
 		resp := new(authpb.ValidateTokenResponse)
-		resp.GetStatus().SetCode(int32(codes.Unauthenticated))
-		resp.GetStatus().SetMessage("Token is invalid or has been revoked")
+		resp.SetStatus(newStatus(codes.Unauthenticated, "Token is invalid or has been revoked"))
 
 		return resp, nil
 	}
 
-	// 解析 token
-	claims, err := s.parseToken(in.GetToken())
-	if err != nil {
-		return nil, errors.Wrap(err, "invalid token")
+	// 檢查 token 所屬的工作階段是否已被撤銷
+	if claims.SessionID != "" {
+		revoked, err := s.isSessionRevoked(ctx, claims.SessionID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to check session")
+		}
+
+		if revoked {
+			resp := new(authpb.ValidateTokenResponse)
+			resp.SetStatus(newStatus(codes.Unauthenticated, "Session has been revoked"))
+
+			return resp, nil
+		}
+	}
+
+	// 以 client_credentials 取得的 token 不代表任何使用者
+	if claims.UserID == "" {
+		resp := new(authpb.ValidateTokenResponse)
+		resp.SetStatus(newStatus(codes.Unauthenticated, "Token does not belong to a user"))
+
+		return resp, nil
 	}
 
 	// 獲取用戶信息
@@ -172,58 +300,123 @@ func (s *gRPCServer) ValidateToken(ctx context.Context, in *authpb.ValidateToken
 		return nil, errors.Wrap(err, "failed to get user")
 	}
 
+	// 停權或刪除的使用者不得繼續使用 token
+	if !user.IsActive() {
+		resp := new(authpb.ValidateTokenResponse)
+		resp.SetStatus(newStatus(codes.Unauthenticated, "User is not active"))
+
+		return resp, nil
+	}
+
 	resp := new(authpb.ValidateTokenResponse)
-	resp.GetStatus().SetCode(int32(codes.OK))
-	resp.GetStatus().SetMessage("Token is valid")
-	resp.GetUser().SetId(user.ID)
-	resp.GetUser().SetEmail(user.Email)
-	resp.GetUser().SetCreatedAt(timestamppb.New(user.CreatedAt))
+	resp.SetStatus(newStatus(codes.OK, "Token is valid"))
+	resp.SetUser(newUser(user))
+	resp.SetSessionId(claims.SessionID)
+	resp.SetRoles(claims.Roles)
+	resp.SetPermissions(claims.Permissions())
 
 	return resp, nil
 }
 
-// Claims 定義 JWT 的聲明
-type Claims struct {
-	UserID string `json:"user_id"`
-	Email  string `json:"email"`
-	jwt.RegisteredClaims
+func (s *gRPCServer) RefreshToken(ctx context.Context, in *authpb.RefreshTokenRequest) (*authpb.RefreshTokenResponse, error) {
+	token, refreshToken, err := s.rotateRefreshToken(ctx, in.GetRefreshToken(), "")
+	if errors.Is(err, errRefreshTokenInvalid) || errors.Is(err, errRefreshTokenReused) {
+		resp := new(authpb.RefreshTokenResponse)
+		resp.SetStatus(newStatus(codes.Unauthenticated, err.Error()))
+
+		return resp, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to refresh token")
+	}
+
+	resp := new(authpb.RefreshTokenResponse)
+	resp.SetStatus(newStatus(codes.OK, "Token refreshed successfully"))
+	resp.SetToken(token)
+	resp.SetRefreshToken(refreshToken)
+
+	return resp, nil
+}
+
+// newStatus 建立回應狀態
+func newStatus(code codes.Code, message string) *authpb.Status {
+	status := new(authpb.Status)
+	status.SetCode(int32(code))
+	status.SetMessage(message)
+
+	return status
+}
+
+// newUser 將使用者實體轉換為 protobuf 訊息
+func newUser(user *entity.User) *authpb.User {
+	pbUser := new(authpb.User)
+	pbUser.SetId(user.ID)
+	pbUser.SetEmail(user.Email)
+	pbUser.SetCreatedAt(timestamppb.New(user.CreatedAt))
+	pbUser.SetStatus(user.Status.String())
+	pbUser.SetName(user.Name)
+	if user.PendingEmail != nil {
+		pbUser.SetPendingEmail(*user.PendingEmail)
+	}
+
+	return pbUser
 }
 
-// generateToken 生成 JWT token 並存儲在 Redis 中
-func (s *gRPCServer) generateToken(ctx context.Context, userID, email string) (string, error) {
+// generateToken 生成屬於指定工作階段的 JWT token 並存儲在 Redis 中
+func (s *gRPCServer) generateToken(ctx context.Context, userID, email, sessionID string) (string, error) {
+	// 每次簽發都重新載入角色，使角色變更於下次更新 token 時生效
+	roles, scope, err := s.accessClaims(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	claims := &entity.Claims{
+		UserID:    userID,
+		Email:     email,
+		SessionID: sessionID,
+		Roles:     roles,
+		Scope:     scope,
+	}
+
+	return s.signAccessToken(ctx, claims, userID)
+}
+
+// signAccessToken 補上 jti、有效期與發行者後簽發 access token，並以 jti 為鍵將擁有者存儲在 Redis 中
+func (s *gRPCServer) signAccessToken(ctx context.Context, claims *entity.Claims, owner string) (string, error) {
 	// 生成 token
-	expirationTime := time.Now().Add(24 * time.Hour) // Token 有效期為 24 小時
-	claims := &Claims{
-		UserID: userID,
-		Email:  email,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(expirationTime),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-		},
+	ttl := s.accessTokenTTL()
+	now := time.Now()
+	claims.RegisteredClaims = jwt.RegisteredClaims{
+		Issuer:    s.cfg.Auth.OAuth2.Issuer,
+		Subject:   claims.RegisteredClaims.Subject,
+		ID:        uuid.New().String(),
+		ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		IssuedAt:  jwt.NewNumericDate(now),
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(s.cfg.Auth.JWTSecret))
+	tokenString, err := s.keys.Sign(claims)
 	if err != nil {
 		return "", errors.Wrap(err, "failed to sign token")
 	}
 
-	// 將 token 存儲在 Redis 中
-	key := fmt.Sprintf("token:%s", tokenString)
-	err = s.redis.Set(ctx, key, userID, 24*time.Hour).Err()
+	// 以 jti 為鍵將 token 存儲在 Redis 中
+	err = s.redis.Set(ctx, tokenKey(claims.ID), owner, ttl).Err()
 	if err != nil {
 		return "", errors.Wrap(err, "failed to store token in Redis")
 	}
+	if err := s.indexToken(ctx, owner, tokenKey(claims.ID), ttl); err != nil {
+		return "", err
+	}
 
 	return tokenString, nil
 }
 
-// invalidateToken 將 token 加入黑名單
-func (s *gRPCServer) invalidateToken(ctx context.Context, tokenString string) error {
+// invalidateToken 將 token 加入黑名單並回傳其聲明
+func (s *gRPCServer) invalidateToken(ctx context.Context, tokenString string) (*entity.Claims, error) {
 	// 解析 token 以獲取過期時間
 	claims, err := s.parseToken(tokenString)
 	if err != nil {
-		return errors.Wrap(err, "failed to parse token")
+		return nil, errors.Wrap(err, "failed to parse token")
 	}
 
 	// 計算 token 剩餘有效期
@@ -231,47 +424,69 @@ func (s *gRPCServer) invalidateToken(ctx context.Context, tokenString string) er
 	ttl := time.Until(expirationTime)
 	if ttl <= 0 {
 		// token 已過期，無需加入黑名單
-		return nil
+		return claims, nil
 	}
 
-	// 將 token 加入黑名單
-	blacklistKey := fmt.Sprintf("blacklist:%s", tokenString)
-	err = s.redis.Set(ctx, blacklistKey, "1", ttl).Err()
+	// 以 jti 為鍵將 token 加入黑名單
+	tokenID := claims.TokenID(tokenString)
+	err = s.redis.Set(ctx, blacklistKey(tokenID), "1", ttl).Err()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to add token to blacklist")
+	}
+
+	// 通知各 HTTP 節點的本地撤銷快取
+	err = revocation.Publish(ctx, s.redis, tokenID, expirationTime)
 	if err != nil {
-		return errors.Wrap(err, "failed to add token to blacklist")
+		s.logger.Warn("Failed to publish token revocation", slog.Any("error", err))
+		// 繼續執行，不返回錯誤
 	}
 
 	// 刪除原有的 token 記錄
-	tokenKey := fmt.Sprintf("token:%s", tokenString)
-	err = s.redis.Del(ctx, tokenKey).Err()
+	err = s.redis.Del(ctx, tokenKey(tokenID)).Err()
 	if err != nil {
 		s.logger.Warn("Failed to delete token from Redis", slog.Any("error", err))
 		// 繼續執行，不返回錯誤
 	}
 
-	return nil
+	return claims, nil
 }
 
-// isTokenInvalid 檢查 token 是否在黑名單中
-func (s *gRPCServer) isTokenInvalid(ctx context.Context, tokenString string) (bool, error) {
-	blacklistKey := fmt.Sprintf("blacklist:%s", tokenString)
-	exists, err := s.redis.Exists(ctx, blacklistKey).Result()
-	if err != nil {
-		return false, errors.Wrap(err, "failed to check token in blacklist")
+// isTokenInvalid 檢查 token 是否在黑名單中，
+// 啟用 LegacyTokenKeys 時同時檢查以完整 token 為鍵的舊黑名單
+func (s *gRPCServer) isTokenInvalid(ctx context.Context, claims *entity.Claims, tokenString string) (bool, error) {
+	keys := []string{blacklistKey(claims.TokenID(tokenString))}
+	if s.cfg.Auth.LegacyTokenKeys {
+		keys = append(keys, fmt.Sprintf("blacklist:%s", tokenString))
+	}
+
+	// 各鍵可能位於不同的 slot，逐一檢查
+	for _, key := range keys {
+		exists, err := s.redis.Exists(ctx, key).Result()
+		if err != nil {
+			return false, errors.Wrap(err, "failed to check token in blacklist")
+		}
+
+		if exists > 0 {
+			return true, nil
+		}
 	}
 
-	return exists > 0, nil
+	return false, nil
 }
 
-// parseToken 解析 JWT token
-func (s *gRPCServer) parseToken(tokenString string) (*Claims, error) {
-	claims := &Claims{}
-	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-		return []byte(s.cfg.Auth.JWTSecret), nil
-	})
+func tokenKey(tokenID string) string {
+	return fmt.Sprintf("token:%s", tokenID)
+}
+
+func blacklistKey(tokenID string) string {
+	return fmt.Sprintf("blacklist:%s", tokenID)
+}
 
-	if err != nil || !token.Valid {
-		return nil, errors.New("invalid token")
+// parseToken 依 kid 選擇金鑰解析 JWT token
+func (s *gRPCServer) parseToken(tokenString string) (*entity.Claims, error) {
+	claims := &entity.Claims{}
+	if err := s.keys.Parse(tokenString, claims); err != nil {
+		return nil, errs.Wrap(err, errs.KindInvalidCredentials, "invalid token")
 	}
 
 	return claims, nil
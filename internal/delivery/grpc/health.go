@@ -0,0 +1,93 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"server-template/internal/domain/lifecycle"
+	"server-template/internal/infrastructure/health"
+	"server-template/proto/pb/adminpb"
+	"server-template/proto/pb/authpb"
+
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const defaultHealthCheckInterval = 10 * time.Second
+
+// serviceDependencies 為各 gRPC 服務依賴的外部資源，任一不可用時該服務回報 NOT_SERVING；
+// 空字串代表整個伺服器，所有服務皆可用時才為 SERVING
+var serviceDependencies = map[string][]health.Dependency{
+	authpb.Auth_ServiceDesc.ServiceName:   {health.Postgres, health.Redis},
+	adminpb.Admin_ServiceDesc.ServiceName: {health.Postgres, health.Redis},
+}
+
+// healthReporter 定期檢查外部資源並更新 grpc.health.v1 的服務狀態
+type healthReporter struct {
+	checker  *health.Checker
+	server   *grpchealth.Server
+	logger   *slog.Logger
+	interval time.Duration
+
+	// failing 為上次檢查失敗的資源，只在狀態改變時記錄日誌
+	failing map[health.Dependency]bool
+}
+
+func newHealthReporter(checker *health.Checker, server *grpchealth.Server, logger *slog.Logger, interval time.Duration) *healthReporter {
+	if interval <= 0 {
+		interval = defaultHealthCheckInterval
+	}
+
+	return &healthReporter{
+		checker:  checker,
+		server:   server,
+		logger:   logger,
+		interval: interval,
+		failing:  make(map[health.Dependency]bool),
+	}
+}
+
+func (r *healthReporter) run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.update(ctx)
+		}
+	}
+}
+
+// update 檢查外部資源並更新各服務狀態；伺服器關閉後 Shutdown 已將狀態固定為 NOT_SERVING，不再更新
+func (r *healthReporter) update(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, min(r.interval, lifecycle.DefaultTimeout))
+	defer cancel()
+
+	results := r.checker.Check(ctx)
+	for dependency, err := range results {
+		switch {
+		case err != nil && !r.failing[dependency]:
+			r.logger.WarnContext(ctx, "Health check failed", slog.String("dependency", string(dependency)), slog.Any("error", err))
+		case err == nil && r.failing[dependency]:
+			r.logger.InfoContext(ctx, "Health check recovered", slog.String("dependency", string(dependency)))
+		}
+		r.failing[dependency] = err != nil
+	}
+
+	overall := healthpb.HealthCheckResponse_SERVING
+	for service, dependencies := range serviceDependencies {
+		status := healthpb.HealthCheckResponse_SERVING
+		for _, dependency := range dependencies {
+			if results[dependency] != nil {
+				status = healthpb.HealthCheckResponse_NOT_SERVING
+				overall = healthpb.HealthCheckResponse_NOT_SERVING
+			}
+		}
+		r.server.SetServingStatus(service, status)
+	}
+	r.server.SetServingStatus("", overall)
+}
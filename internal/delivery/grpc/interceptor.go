@@ -0,0 +1,309 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"server-template/internal/domain/audit"
+	"server-template/internal/infrastructure/rpc"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// errorInterceptor 將 handler 回傳的錯誤轉換為對應的 gRPC 狀態，
+// 未歸類的錯誤會完整記錄後以 INTERNAL 回傳，避免內部訊息外洩
+func errorInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err == nil {
+			return resp, nil
+		}
+
+		st := rpc.ToStatus(err)
+		if st.Code() == codes.Internal || st.Code() == codes.Unavailable {
+			logger.ErrorContext(ctx, "gRPC request failed",
+				slog.String("method", info.FullMethod),
+				slog.String("code", st.Code().String()),
+				slog.String("request_id", rpc.RequestIDFromContext(ctx)),
+				slog.Any("error", err),
+			)
+		}
+
+		return nil, st.Err()
+	}
+}
+
+// clientInfoInterceptor 將用戶端資訊放入 context，供稽核事件、登入節流與工作階段記錄使用；
+// 只有受信任的呼叫端在請求中轉送的用戶端資訊會被採用
+func (s *gRPCServer) clientInfoInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	return handler(audit.WithClient(ctx, s.clientInfo(ctx, req)), req)
+}
+
+// maxRequestIDLength 為沿用呼叫端請求 ID 的長度上限，超過時改為產生新的 ID
+const maxRequestIDLength = 128
+
+// unaryInterceptors 依設定組合 unary interceptor，由外而內依序為：
+// 請求 ID、存取日誌、panic 復原、錯誤轉換、deadline、用戶端資訊、權限檢查、限流、請求驗證
+func (s *gRPCServer) unaryInterceptors() []grpc.UnaryServerInterceptor {
+	cfg := s.cfg.RPC.Server.Interceptors
+
+	var chain []grpc.UnaryServerInterceptor
+	if cfg.RequestID {
+		chain = append(chain, requestIDInterceptor(s.logger))
+	}
+	if cfg.AccessLog {
+		chain = append(chain, accessLogInterceptor(s.logger))
+	}
+	if cfg.Recovery {
+		chain = append(chain, recoveryInterceptor(s.logger))
+	}
+	chain = append(chain, errorInterceptor(s.logger))
+	if cfg.DefaultTimeout > 0 || cfg.MaxTimeout > 0 {
+		chain = append(chain, deadlineInterceptor(cfg.DefaultTimeout, cfg.MaxTimeout))
+	}
+	chain = append(chain, s.clientInfoInterceptor, s.permissionInterceptor, s.rateLimitInterceptor)
+	if cfg.Validation {
+		chain = append(chain, validationInterceptor)
+	}
+
+	return chain
+}
+
+// streamInterceptors 依設定組合 stream interceptor，順序與 unaryInterceptors 相同；
+// 權限檢查與限流目前僅用於 unary 方法
+func (s *gRPCServer) streamInterceptors() []grpc.StreamServerInterceptor {
+	cfg := s.cfg.RPC.Server.Interceptors
+
+	var chain []grpc.StreamServerInterceptor
+	if cfg.RequestID {
+		chain = append(chain, streamRequestIDInterceptor(s.logger))
+	}
+	if cfg.AccessLog {
+		chain = append(chain, streamAccessLogInterceptor(s.logger))
+	}
+	if cfg.Recovery {
+		chain = append(chain, streamRecoveryInterceptor(s.logger))
+	}
+	chain = append(chain, streamErrorInterceptor(s.logger))
+	if cfg.DefaultTimeout > 0 || cfg.MaxTimeout > 0 {
+		chain = append(chain, streamDeadlineInterceptor(cfg.DefaultTimeout, cfg.MaxTimeout))
+	}
+	if cfg.Validation {
+		chain = append(chain, streamValidationInterceptor)
+	}
+
+	return chain
+}
+
+// streamErrorInterceptor 為 errorInterceptor 的 stream 版本
+func streamErrorInterceptor(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := handler(srv, ss)
+		if err == nil {
+			return nil
+		}
+
+		st := rpc.ToStatus(err)
+		if st.Code() == codes.Internal || st.Code() == codes.Unavailable {
+			logger.ErrorContext(ss.Context(), "gRPC stream failed",
+				slog.String("method", info.FullMethod),
+				slog.String("code", st.Code().String()),
+				slog.String("request_id", rpc.RequestIDFromContext(ss.Context())),
+				slog.Any("error", err),
+			)
+		}
+
+		return st.Err()
+	}
+}
+
+// requestIDInterceptor 沿用呼叫端 x-request-id metadata 中的請求 ID，未帶上時產生新的 ID，
+// 並放入 context 與回應的 header metadata，呼叫其他服務時會繼續傳遞
+func requestIDInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		requestID := incomingRequestID(ctx)
+		if err := grpc.SetHeader(ctx, metadata.Pairs(rpc.RequestIDKey, requestID)); err != nil {
+			logger.DebugContext(ctx, "Failed to set request ID metadata", slog.Any("error", err))
+		}
+
+		return handler(rpc.WithRequestID(ctx, requestID), req)
+	}
+}
+
+func streamRequestIDInterceptor(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		requestID := incomingRequestID(ss.Context())
+		if err := ss.SetHeader(metadata.Pairs(rpc.RequestIDKey, requestID)); err != nil {
+			logger.DebugContext(ss.Context(), "Failed to set request ID metadata", slog.Any("error", err))
+		}
+
+		return handler(srv, &serverStream{ServerStream: ss, ctx: rpc.WithRequestID(ss.Context(), requestID)})
+	}
+}
+
+func incomingRequestID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(rpc.RequestIDKey); len(values) > 0 && values[0] != "" && len(values[0]) <= maxRequestIDLength {
+			return values[0]
+		}
+	}
+
+	return uuid.NewString()
+}
+
+// accessLogInterceptor 於每次呼叫結束後記錄方法、狀態碼與耗時，伺服器端錯誤以 Error 等級記錄
+func accessLogInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		logAccess(ctx, logger, info.FullMethod, start, err)
+
+		return resp, err
+	}
+}
+
+func streamAccessLogInterceptor(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		logAccess(ss.Context(), logger, info.FullMethod, start, err)
+
+		return err
+	}
+}
+
+func logAccess(ctx context.Context, logger *slog.Logger, method string, start time.Time, err error) {
+	// 健康檢查由探針頻繁呼叫，不記錄
+	if strings.HasPrefix(method, "/"+healthpb.Health_ServiceDesc.ServiceName+"/") {
+		return
+	}
+
+	code := status.Code(err)
+
+	level := slog.LevelInfo
+	switch code {
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable:
+		level = slog.LevelError
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", method),
+		slog.String("code", code.String()),
+		slog.Duration("duration", time.Since(start)),
+		slog.String("request_id", rpc.RequestIDFromContext(ctx)),
+		slog.String("ip_address", peerClientInfo(ctx).IPAddress),
+	}
+	if identity, ok := rpc.PeerIdentityFromContext(ctx); ok {
+		attrs = append(attrs, slog.String("client_identity", identity.Name()))
+	}
+
+	logger.LogAttrs(ctx, level, "gRPC request", attrs...)
+}
+
+// recoveryInterceptor 將 handler 的 panic 轉為 INTERNAL 並記錄堆疊，避免整個連線中斷
+func recoveryInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(ctx, logger, info.FullMethod, r)
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+func streamRecoveryInterceptor(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(ss.Context(), logger, info.FullMethod, r)
+			}
+		}()
+
+		return handler(srv, ss)
+	}
+}
+
+func recovered(ctx context.Context, logger *slog.Logger, method string, r any) error {
+	logger.ErrorContext(ctx, "gRPC handler panicked",
+		slog.String("method", method),
+		slog.String("request_id", rpc.RequestIDFromContext(ctx)),
+		slog.Any("panic", r),
+		slog.String("stack", string(debug.Stack())),
+	)
+
+	return status.Error(codes.Internal, "internal error")
+}
+
+// deadlineInterceptor 為未設定 deadline 的呼叫套用 defaultTimeout，並將超過 maxTimeout 的 deadline 縮短；
+// 抵達時已逾時的呼叫直接回傳 DEADLINE_EXCEEDED，不執行 handler
+func deadlineInterceptor(defaultTimeout, maxTimeout time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, cancel := withDeadline(ctx, defaultTimeout, maxTimeout)
+		defer cancel()
+
+		if err := ctx.Err(); err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func streamDeadlineInterceptor(defaultTimeout, maxTimeout time.Duration) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, cancel := withDeadline(ss.Context(), defaultTimeout, maxTimeout)
+		defer cancel()
+
+		if err := ctx.Err(); err != nil {
+			return errors.WithStack(err)
+		}
+
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func withDeadline(ctx context.Context, defaultTimeout, maxTimeout time.Duration) (context.Context, context.CancelFunc) {
+	deadline, ok := ctx.Deadline()
+	switch {
+	case !ok && defaultTimeout > 0:
+		return context.WithTimeout(ctx, defaultTimeout)
+	case maxTimeout > 0 && (!ok || time.Until(deadline) > maxTimeout):
+		return context.WithTimeout(ctx, maxTimeout)
+	default:
+		return ctx, func() {}
+	}
+}
+
+// serverStream 以新的 context 包裝 ServerStream，validate 為 true 時驗證每則收到的訊息
+type serverStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	validate bool
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *serverStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if s.validate {
+		return validateRequest(m)
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package grpc
+
+import (
+	"context"
+
+	"server-template/proto/pb/authpb"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+)
+
+func (s *gRPCServer) UnlockAccount(ctx context.Context, in *authpb.UnlockAccountRequest) (*authpb.UnlockAccountResponse, error) {
+	if err := s.auth.UnlockAccount(ctx, in.GetEmail()); err != nil {
+		return nil, errors.Wrap(err, "auth.UnlockAccount")
+	}
+
+	resp := new(authpb.UnlockAccountResponse)
+	resp.SetStatus(newStatus(codes.OK, "Account unlocked successfully"))
+
+	return resp, nil
+}
@@ -0,0 +1,74 @@
+package grpc
+
+import (
+	"context"
+
+	"server-template/internal/domain/audit"
+	"server-template/internal/domain/entity"
+	"server-template/proto/pb/authpb"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+)
+
+func (s *gRPCServer) VerifyMFA(ctx context.Context, in *authpb.VerifyMFARequest) (*authpb.VerifyMFAResponse, error) {
+	client := audit.ClientFromContext(ctx)
+
+	user, err := s.auth.VerifyMFA(ctx, in.GetChallengeId(), in.GetCode())
+	if err != nil {
+		return nil, errors.Wrap(err, "auth.VerifyMFA")
+	}
+
+	// 建立工作階段並生成 access token 與 refresh token
+	token, refreshToken, err := s.issueTokenPair(ctx, user.ID, user.Email, client)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate token")
+	}
+	s.recordEvent(ctx, entity.AuditEventLogin, user.ID, user.ID)
+
+	resp := new(authpb.VerifyMFAResponse)
+	resp.SetStatus(newStatus(codes.OK, "Login successful"))
+	resp.SetUser(newUser(user))
+	resp.SetToken(token)
+	resp.SetRefreshToken(refreshToken)
+
+	return resp, nil
+}
+
+func (s *gRPCServer) EnrollMFA(ctx context.Context, in *authpb.EnrollMFARequest) (*authpb.EnrollMFAResponse, error) {
+	enrollment, err := s.auth.EnrollMFA(ctx, in.GetUserId())
+	if err != nil {
+		return nil, errors.Wrap(err, "auth.EnrollMFA")
+	}
+
+	resp := new(authpb.EnrollMFAResponse)
+	resp.SetStatus(newStatus(codes.OK, "Scan the URI with an authenticator app and confirm with a code"))
+	resp.SetSecret(enrollment.Secret)
+	resp.SetOtpauthUri(enrollment.URI)
+
+	return resp, nil
+}
+
+func (s *gRPCServer) ConfirmMFA(ctx context.Context, in *authpb.ConfirmMFARequest) (*authpb.ConfirmMFAResponse, error) {
+	recoveryCodes, err := s.auth.ConfirmMFA(ctx, in.GetUserId(), in.GetCode())
+	if err != nil {
+		return nil, errors.Wrap(err, "auth.ConfirmMFA")
+	}
+
+	resp := new(authpb.ConfirmMFAResponse)
+	resp.SetStatus(newStatus(codes.OK, "MFA enabled successfully"))
+	resp.SetRecoveryCodes(recoveryCodes)
+
+	return resp, nil
+}
+
+func (s *gRPCServer) DisableMFA(ctx context.Context, in *authpb.DisableMFARequest) (*authpb.DisableMFAResponse, error) {
+	if err := s.auth.DisableMFA(ctx, in.GetUserId(), in.GetCode()); err != nil {
+		return nil, errors.Wrap(err, "auth.DisableMFA")
+	}
+
+	resp := new(authpb.DisableMFAResponse)
+	resp.SetStatus(newStatus(codes.OK, "MFA disabled successfully"))
+
+	return resp, nil
+}
@@ -0,0 +1,336 @@
+package grpc
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/errs"
+	"server-template/proto/pb/authpb"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	tokenTypeBearer = "Bearer"
+
+	// tokenTypeHintRefreshToken 為 RFC 7009 定義的 token_type_hint，表示 token 應為 refresh token
+	tokenTypeHintRefreshToken = "refresh_token"
+)
+
+func (s *gRPCServer) CreateOAuthClient(ctx context.Context, in *authpb.CreateOAuthClientRequest) (*authpb.CreateOAuthClientResponse, error) {
+	// permissionInterceptor 已驗證呼叫者，用戶端的 scopes 不得超出呼叫者本身的權限
+	caller := ctx.Value(claimsContextKey{}).(*entity.Claims)
+	client, secret, err := s.auth.CreateOAuthClient(ctx, in.GetName(), in.GetScopes(), in.GetGrantTypes(), caller.Permissions())
+	if err != nil {
+		return nil, errors.Wrap(err, "auth.CreateOAuthClient")
+	}
+
+	resp := new(authpb.CreateOAuthClientResponse)
+	resp.SetStatus(newStatus(codes.OK, "OAuth client created, store the client secret securely as it will not be shown again"))
+	resp.SetClient(newOAuthClient(client))
+	resp.SetClientSecret(secret)
+
+	return resp, nil
+}
+
+func (s *gRPCServer) RevokeOAuthClient(ctx context.Context, in *authpb.RevokeOAuthClientRequest) (*authpb.RevokeOAuthClientResponse, error) {
+	if err := s.auth.RevokeOAuthClient(ctx, in.GetClientId()); err != nil {
+		return nil, errors.Wrap(err, "auth.RevokeOAuthClient")
+	}
+
+	resp := new(authpb.RevokeOAuthClientResponse)
+	resp.SetStatus(newStatus(codes.OK, "OAuth client revoked successfully"))
+
+	return resp, nil
+}
+
+// IssueOAuth2Token 依 grant_type 簽發 token。
+// client_credentials 需驗證用戶端；refresh_token 於提供 client_id 時驗證用戶端，
+// 未提供時視為第一方用戶端，與 RefreshToken 相同，兩者皆只能輪替簽發給自己的 refresh token。
+func (s *gRPCServer) IssueOAuth2Token(ctx context.Context, in *authpb.IssueOAuth2TokenRequest) (*authpb.IssueOAuth2TokenResponse, error) {
+	credentials := entity.ClientCredentials{ClientID: in.GetClientId(), ClientSecret: in.GetClientSecret()}
+
+	var (
+		token *entity.OAuth2Token
+		err   error
+	)
+	switch in.GetGrantType() {
+	case entity.GrantTypeClientCredentials:
+		token, err = s.clientCredentialsGrant(ctx, credentials, in.GetScope())
+	case entity.GrantTypeRefreshToken:
+		token, err = s.refreshTokenGrant(ctx, credentials, in.GetRefreshToken())
+	default:
+		err = errs.FieldError("grant_type", errors.Errorf("unsupported grant type %q", in.GetGrantType()))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(authpb.IssueOAuth2TokenResponse)
+	resp.SetStatus(newStatus(codes.OK, "Token issued successfully"))
+	resp.SetAccessToken(token.AccessToken)
+	resp.SetTokenType(token.TokenType)
+	resp.SetExpiresIn(int64(token.ExpiresIn.Seconds()))
+	resp.SetRefreshToken(token.RefreshToken)
+	resp.SetScope(token.Scope)
+
+	return resp, nil
+}
+
+// IntrospectToken 回傳 token 的狀態，無效、過期或已撤銷的 token 皆只回傳 active=false
+func (s *gRPCServer) IntrospectToken(ctx context.Context, in *authpb.IntrospectTokenRequest) (*authpb.IntrospectTokenResponse, error) {
+	if _, err := s.auth.AuthenticateOAuthClient(ctx, entity.ClientCredentials{ClientID: in.GetClientId(), ClientSecret: in.GetClientSecret()}); err != nil {
+		return nil, errors.Wrap(err, "auth.AuthenticateOAuthClient")
+	}
+
+	// 依 token_type_hint 決定檢查順序，找不到時仍會檢查另一種 token
+	lookups := []func(context.Context, string) (*entity.TokenIntrospection, error){s.introspectAccessToken, s.introspectRefreshToken}
+	if in.GetTokenTypeHint() == tokenTypeHintRefreshToken {
+		slices.Reverse(lookups)
+	}
+
+	introspection := &entity.TokenIntrospection{}
+	for _, lookup := range lookups {
+		result, err := lookup(ctx, in.GetToken())
+		if err != nil {
+			return nil, err
+		}
+		if result.Active {
+			introspection = result
+
+			break
+		}
+	}
+
+	resp := new(authpb.IntrospectTokenResponse)
+	resp.SetStatus(newStatus(codes.OK, "Token introspected successfully"))
+	resp.SetActive(introspection.Active)
+	if introspection.Active {
+		resp.SetScope(introspection.Scope)
+		resp.SetClientId(introspection.ClientID)
+		resp.SetUsername(introspection.Username)
+		resp.SetSub(introspection.Subject)
+		resp.SetTokenType(introspection.TokenType)
+		resp.SetJti(introspection.TokenID)
+		resp.SetExp(timestamppb.New(introspection.ExpiresAt))
+		if !introspection.IssuedAt.IsZero() {
+			resp.SetIat(timestamppb.New(introspection.IssuedAt))
+		}
+	}
+
+	return resp, nil
+}
+
+// RevokeToken 撤銷簽發給該用戶端的 access token。
+// 依 RFC 7009，無效或已撤銷的 token 也視為撤銷成功；用戶端不得撤銷不是簽發給自己的 token，
+// 包含使用者登入取得的 access token 與 refresh token，此時不撤銷並同樣回傳成功，避免洩漏 token 是否有效。
+func (s *gRPCServer) RevokeToken(ctx context.Context, in *authpb.RevokeTokenRequest) (*authpb.RevokeTokenResponse, error) {
+	client, err := s.auth.AuthenticateOAuthClient(ctx, entity.ClientCredentials{ClientID: in.GetClientId(), ClientSecret: in.GetClientSecret()})
+	if err != nil {
+		return nil, errors.Wrap(err, "auth.AuthenticateOAuthClient")
+	}
+
+	resp := new(authpb.RevokeTokenResponse)
+	resp.SetStatus(newStatus(codes.OK, "Token revoked successfully"))
+
+	// refresh token 不是 JWT 且只簽發給第一方應用程式，不屬於任何用戶端
+	claims, err := s.parseToken(in.GetToken())
+	if err != nil || claims.ClientID != client.ID {
+		return resp, nil
+	}
+
+	if _, err := s.invalidateToken(ctx, in.GetToken()); err != nil {
+		return nil, errors.Wrap(err, "failed to invalidate token")
+	}
+	s.recordEvent(ctx, entity.AuditEventTokenRevoke, client.ID, claims.UserID)
+
+	return resp, nil
+}
+
+// clientCredentialsGrant 以用戶端本身的身分簽發 access token，scope 需為用戶端可申請的權限，
+// 未指定時授予全部可申請的權限。依 RFC 6749 第 4.4.3 節不簽發 refresh token。
+func (s *gRPCServer) clientCredentialsGrant(ctx context.Context, credentials entity.ClientCredentials, scope string) (*entity.OAuth2Token, error) {
+	client, err := s.authenticateGrantClient(ctx, credentials, entity.GrantTypeClientCredentials)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes := strings.Fields(scope)
+	if len(scopes) == 0 {
+		scopes = client.ScopeList()
+	}
+	for _, requested := range scopes {
+		if !slices.Contains(client.ScopeList(), requested) {
+			return nil, errs.FieldError("scope", errors.Errorf("scope %q is not allowed for this client", requested))
+		}
+	}
+	scope = strings.Join(slices.Compact(slices.Sorted(slices.Values(scopes))), " ")
+
+	claims := &entity.Claims{ClientID: client.ID, Scope: scope}
+	claims.Subject = client.ID
+
+	accessToken, err := s.signAccessToken(ctx, claims, client.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.OAuth2Token{
+		AccessToken: accessToken,
+		TokenType:   tokenTypeBearer,
+		ExpiresIn:   s.accessTokenTTL(),
+		Scope:       scope,
+	}, nil
+}
+
+// refreshTokenGrant 輪替 refresh token，重複使用舊的 refresh token 時會撤銷整個 token family。
+// 簽發給用戶端的 refresh token 只能由該用戶端驗證後輪替，用戶端也不能輪替第一方應用程式的 refresh token
+func (s *gRPCServer) refreshTokenGrant(ctx context.Context, credentials entity.ClientCredentials, refreshToken string) (*entity.OAuth2Token, error) {
+	var clientID string
+	if credentials.ClientID != "" {
+		client, err := s.authenticateGrantClient(ctx, credentials, entity.GrantTypeRefreshToken)
+		if err != nil {
+			return nil, err
+		}
+		clientID = client.ID
+	}
+
+	accessToken, newRefreshToken, err := s.rotateRefreshToken(ctx, refreshToken, clientID)
+	if errors.Is(err, errRefreshTokenInvalid) || errors.Is(err, errRefreshTokenReused) {
+		return nil, errs.FieldError("refresh_token", err)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to refresh token")
+	}
+
+	return &entity.OAuth2Token{
+		AccessToken:  accessToken,
+		TokenType:    tokenTypeBearer,
+		ExpiresIn:    s.accessTokenTTL(),
+		RefreshToken: newRefreshToken,
+	}, nil
+}
+
+// authenticateGrantClient 驗證用戶端並確認其可使用指定的 grant type
+func (s *gRPCServer) authenticateGrantClient(ctx context.Context, credentials entity.ClientCredentials, grantType string) (*entity.OAuthClient, error) {
+	client, err := s.auth.AuthenticateOAuthClient(ctx, credentials)
+	if err != nil {
+		return nil, errors.Wrap(err, "auth.AuthenticateOAuthClient")
+	}
+
+	if !client.AllowsGrant(grantType) {
+		return nil, errs.New(errs.KindPermissionDenied, "client is not allowed to use grant type "+grantType)
+	}
+
+	return client, nil
+}
+
+// introspectAccessToken 檢查 access token 的簽章、撤銷狀態、所屬工作階段與使用者
+func (s *gRPCServer) introspectAccessToken(ctx context.Context, tokenString string) (*entity.TokenIntrospection, error) {
+	inactive := &entity.TokenIntrospection{}
+
+	// 無法解析或已過期的 token 視為無效
+	claims, err := s.parseToken(tokenString)
+	if err != nil {
+		return inactive, nil
+	}
+
+	invalid, err := s.isTokenInvalid(ctx, claims, tokenString)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to check token validity")
+	}
+	if !invalid && claims.SessionID != "" {
+		invalid, err = s.isSessionRevoked(ctx, claims.SessionID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to check session")
+		}
+	}
+	if invalid {
+		return inactive, nil
+	}
+
+	subject := claims.Subject
+	if claims.UserID != "" {
+		subject = claims.UserID
+
+		// 停權或刪除的使用者不得繼續使用 token
+		user, err := s.auth.GetUserByID(ctx, claims.UserID)
+		if errors.Is(err, errs.ErrNotFound) {
+			return inactive, nil
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get user")
+		}
+		if !user.IsActive() {
+			return inactive, nil
+		}
+	}
+
+	introspection := &entity.TokenIntrospection{
+		Active:    true,
+		Scope:     claims.Scope,
+		ClientID:  claims.ClientID,
+		Username:  claims.Email,
+		Subject:   subject,
+		TokenType: tokenTypeBearer,
+		TokenID:   claims.ID,
+		ExpiresAt: claims.ExpiresAt.Time,
+	}
+	if claims.IssuedAt != nil {
+		introspection.IssuedAt = claims.IssuedAt.Time
+	}
+
+	return introspection, nil
+}
+
+// introspectRefreshToken 檢查 refresh token 是否仍為其 token family 目前有效的 token
+func (s *gRPCServer) introspectRefreshToken(ctx context.Context, refreshToken string) (*entity.TokenIntrospection, error) {
+	inactive := &entity.TokenIntrospection{}
+
+	record, err := s.getRefreshToken(ctx, refreshToken)
+	if errors.Is(err, errRefreshTokenInvalid) {
+		return inactive, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tokenHash := hashRefreshToken(refreshToken)
+	current, err := s.redis.Get(ctx, refreshFamilyKey(record.FamilyID)).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, errors.Wrap(err, "failed to get refresh token family from Redis")
+	}
+	if current != tokenHash {
+		return inactive, nil
+	}
+
+	ttl, err := s.redis.PTTL(ctx, refreshTokenKey(tokenHash)).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get refresh token expiry from Redis")
+	}
+
+	return &entity.TokenIntrospection{
+		Active:    true,
+		ClientID:  record.ClientID,
+		Username:  record.Email,
+		Subject:   record.UserID,
+		ExpiresAt: time.Now().Add(ttl),
+	}, nil
+}
+
+// newOAuthClient 將 OAuth2 用戶端實體轉換為 protobuf 訊息
+func newOAuthClient(client *entity.OAuthClient) *authpb.OAuthClient {
+	pbClient := new(authpb.OAuthClient)
+	pbClient.SetId(client.ID)
+	pbClient.SetName(client.Name)
+	pbClient.SetScopes(client.ScopeList())
+	pbClient.SetGrantTypes(client.GrantTypeList())
+	pbClient.SetCreatedAt(timestamppb.New(client.CreatedAt))
+
+	return pbClient
+}
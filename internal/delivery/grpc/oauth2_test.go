@@ -0,0 +1,57 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"server-template/config"
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/errs"
+	"server-template/internal/domain/usecase"
+	"server-template/internal/infrastructure/redistest"
+)
+
+// fakeOAuthClientAuth 接受任何 secret，用戶端 ID 即為憑證中的 client_id
+type fakeOAuthClientAuth struct {
+	usecase.AuthUseCase
+}
+
+func (fakeOAuthClientAuth) AuthenticateOAuthClient(_ context.Context, credentials entity.ClientCredentials) (*entity.OAuthClient, error) {
+	return &entity.OAuthClient{ID: credentials.ClientID, GrantTypes: entity.GrantTypeRefreshToken}, nil
+}
+
+func TestRefreshTokenGrantRequiresIssuingClient(t *testing.T) {
+	tests := []struct {
+		name        string
+		issuedTo    string
+		credentials entity.ClientCredentials
+	}{
+		{name: "client token without client authentication", issuedTo: "client-1"},
+		{name: "client token with another client", issuedTo: "client-1", credentials: entity.ClientCredentials{ClientID: "client-2", ClientSecret: "secret"}},
+		{name: "first-party token with a client", credentials: entity.ClientCredentials{ClientID: "client-2", ClientSecret: "secret"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := &gRPCServer{
+				auth:  fakeOAuthClientAuth{},
+				cfg:   new(config.Config),
+				redis: redistest.NewServer(t).Client(t),
+			}
+
+			refreshToken, _, err := server.storeRefreshToken(context.Background(), &refreshTokenRecord{
+				UserID:   "user-1",
+				FamilyID: "session-1",
+				ClientID: tt.issuedTo,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = server.refreshTokenGrant(context.Background(), tt.credentials, refreshToken)
+			if errs.KindOf(err) != errs.KindValidationFailed {
+				t.Fatalf("refreshTokenGrant error = %v, want invalid refresh token", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package grpc
+
+import (
+	"context"
+
+	"server-template/internal/domain/audit"
+	"server-template/proto/pb/authpb"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+)
+
+func (s *gRPCServer) StartOIDCLogin(ctx context.Context, in *authpb.StartOIDCLoginRequest) (*authpb.StartOIDCLoginResponse, error) {
+	authURL, state, err := s.auth.StartOIDCLogin(ctx, in.GetProvider())
+	if err != nil {
+		return nil, errors.Wrap(err, "auth.StartOIDCLogin")
+	}
+
+	resp := new(authpb.StartOIDCLoginResponse)
+	resp.SetStatus(newStatus(codes.OK, "Redirect to the identity provider"))
+	resp.SetAuthorizationUrl(authURL)
+	resp.SetState(state)
+
+	return resp, nil
+}
+
+func (s *gRPCServer) CompleteOIDCLogin(ctx context.Context, in *authpb.CompleteOIDCLoginRequest) (*authpb.LoginResponse, error) {
+	user, err := s.auth.CompleteOIDCLogin(ctx, in.GetProvider(), in.GetState(), in.GetCode())
+	if err != nil {
+		return nil, errors.Wrap(err, "auth.CompleteOIDCLogin")
+	}
+
+	return s.loginResponse(ctx, user, audit.ClientFromContext(ctx))
+}
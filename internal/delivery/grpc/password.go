@@ -0,0 +1,39 @@
+package grpc
+
+import (
+	"context"
+
+	"server-template/proto/pb/authpb"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+)
+
+func (s *gRPCServer) RequestPasswordReset(ctx context.Context, in *authpb.RequestPasswordResetRequest) (*authpb.RequestPasswordResetResponse, error) {
+	if err := s.auth.RequestPasswordReset(ctx, in.GetEmail()); err != nil {
+		return nil, errors.Wrap(err, "auth.RequestPasswordReset")
+	}
+
+	// 無論帳號是否存在都回傳相同的結果
+	resp := new(authpb.RequestPasswordResetResponse)
+	resp.SetStatus(newStatus(codes.OK, "If the account exists, a password reset link has been sent"))
+
+	return resp, nil
+}
+
+func (s *gRPCServer) ResetPassword(ctx context.Context, in *authpb.ResetPasswordRequest) (*authpb.ResetPasswordResponse, error) {
+	user, err := s.auth.ResetPassword(ctx, in.GetToken(), in.GetNewPassword())
+	if err != nil {
+		return nil, errors.Wrap(err, "auth.ResetPassword")
+	}
+
+	// 密碼變更後結束使用者所有的工作階段
+	if _, err := s.revokeAllSessions(ctx, user.ID, ""); err != nil {
+		return nil, err
+	}
+
+	resp := new(authpb.ResetPasswordResponse)
+	resp.SetStatus(newStatus(codes.OK, "Password reset successful"))
+
+	return resp, nil
+}
@@ -0,0 +1,74 @@
+package grpc
+
+import (
+	"context"
+
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/errs"
+	"server-template/proto/pb/authpb"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+)
+
+func (s *gRPCServer) GetProfile(ctx context.Context, in *authpb.GetProfileRequest) (*authpb.GetProfileResponse, error) {
+	user, err := s.auth.GetUserByID(ctx, in.GetUserId())
+	if err != nil {
+		return nil, errors.Wrap(err, "auth.GetUserByID")
+	}
+
+	resp := new(authpb.GetProfileResponse)
+	resp.SetStatus(newStatus(codes.OK, "Profile retrieved"))
+	resp.SetUser(newUser(user))
+
+	return resp, nil
+}
+
+func (s *gRPCServer) UpdateProfile(ctx context.Context, in *authpb.UpdateProfileRequest) (*authpb.UpdateProfileResponse, error) {
+	var name, email *string
+	if in.HasName() {
+		value := in.GetName()
+		name = &value
+	}
+	if in.HasEmail() {
+		// email 可用於重設密碼，與密碼同樣不允許以 API key 變更
+		if claims, ok := ctx.Value(claimsContextKey{}).(*entity.Claims); ok && claims.APIKeyID != "" {
+			return nil, errs.New(errs.KindPermissionDenied, "email cannot be changed with an API key")
+		}
+		value := in.GetEmail()
+		email = &value
+	}
+
+	user, err := s.auth.UpdateProfile(ctx, in.GetUserId(), name, email)
+	if err != nil {
+		return nil, errors.Wrap(err, "auth.UpdateProfile")
+	}
+
+	resp := new(authpb.UpdateProfileResponse)
+	resp.SetStatus(newStatus(codes.OK, "Profile updated"))
+	resp.SetUser(newUser(user))
+
+	return resp, nil
+}
+
+func (s *gRPCServer) ChangePassword(ctx context.Context, in *authpb.ChangePasswordRequest) (*authpb.ChangePasswordResponse, error) {
+	if err := s.auth.ChangePassword(ctx, in.GetUserId(), in.GetCurrentPassword(), in.GetNewPassword()); err != nil {
+		return nil, errors.Wrap(err, "auth.ChangePassword")
+	}
+
+	// 密碼變更後結束發起變更以外的所有工作階段；發起變更的工作階段取自呼叫端的 token，不採用請求中的值
+	var keepSessionID string
+	if claims, ok := ctx.Value(claimsContextKey{}).(*entity.Claims); ok {
+		keepSessionID = claims.SessionID
+	}
+	revoked, err := s.revokeAllSessions(ctx, in.GetUserId(), keepSessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(authpb.ChangePasswordResponse)
+	resp.SetStatus(newStatus(codes.OK, "Password changed"))
+	resp.SetRevokedSessions(revoked)
+
+	return resp, nil
+}
@@ -0,0 +1,48 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"strconv"
+
+	"server-template/internal/domain/audit"
+	"server-template/internal/domain/entity"
+	"server-template/internal/infrastructure/ratelimit"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// rateLimitInterceptor 依完整方法名稱套用限流規則，需置於 permissionInterceptor 之後才能依使用者與 API key 限流。
+// 結果以 ratelimit-* header metadata 回傳，超過額度時回傳帶有 RetryInfo 的 RESOURCE_EXHAUSTED。
+func (s *gRPCServer) rateLimitInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	keys := map[ratelimit.KeyType]string{
+		// 用戶端資訊已由 clientInfoInterceptor 決定，只有信任的轉送者才能指定請求中的 IP
+		ratelimit.KeyIP: audit.ClientFromContext(ctx).IPAddress,
+	}
+	if claims, ok := ctx.Value(claimsContextKey{}).(*entity.Claims); ok {
+		keys[ratelimit.KeyUser] = claims.UserID
+		keys[ratelimit.KeyAPIKey] = claims.APIKeyID
+	}
+
+	result := s.limiter.Check(ctx, info.FullMethod, keys)
+	if result == nil {
+		return handler(ctx, req)
+	}
+
+	// header 無法送出時仍依結果處理請求
+	if err := grpc.SetHeader(ctx, metadata.Pairs(
+		"ratelimit-limit", strconv.Itoa(result.Limit),
+		"ratelimit-remaining", strconv.Itoa(result.Remaining),
+		"ratelimit-reset", strconv.FormatInt(int64(math.Ceil(result.ResetAfter.Seconds())), 10),
+	)); err != nil {
+		s.logger.DebugContext(ctx, "Failed to set rate limit metadata", slog.Any("error", err))
+	}
+
+	if !result.Allowed {
+		return nil, &entity.RateLimitedError{RetryAfter: result.RetryAfter}
+	}
+
+	return handler(ctx, req)
+}
@@ -0,0 +1,169 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"server-template/internal/domain/audit"
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/errs"
+	"server-template/proto/pb/authpb"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+type claimsContextKey struct{}
+
+func (s *gRPCServer) GrantRole(ctx context.Context, in *authpb.GrantRoleRequest) (*authpb.GrantRoleResponse, error) {
+	if err := s.auth.GrantRole(ctx, in.GetUserId(), in.GetRole()); err != nil {
+		return nil, errors.Wrap(err, "auth.GrantRole")
+	}
+
+	resp := new(authpb.GrantRoleResponse)
+	resp.SetStatus(newStatus(codes.OK, "Role granted successfully"))
+
+	return resp, nil
+}
+
+func (s *gRPCServer) RevokeRole(ctx context.Context, in *authpb.RevokeRoleRequest) (*authpb.RevokeRoleResponse, error) {
+	if err := s.auth.RevokeRole(ctx, in.GetUserId(), in.GetRole()); err != nil {
+		return nil, errors.Wrap(err, "auth.RevokeRole")
+	}
+
+	resp := new(authpb.RevokeRoleResponse)
+	resp.SetStatus(newStatus(codes.OK, "Role revoked successfully"))
+
+	return resp, nil
+}
+
+// permissionInterceptor 依方法上的 required_permission、self_only 與 interactive_only 選項檢查呼叫端 token 的權限與身分，
+// 皆未設定的方法不需驗證
+func (s *gRPCServer) permissionInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	options := methodOptions(info.FullMethod)
+	permission, _ := proto.GetExtension(options, authpb.E_RequiredPermission).(string)
+	selfOnly, _ := proto.GetExtension(options, authpb.E_SelfOnly).(bool)
+	interactiveOnly, _ := proto.GetExtension(options, authpb.E_InteractiveOnly).(bool)
+	if permission == "" && !selfOnly && !interactiveOnly {
+		return handler(ctx, req)
+	}
+
+	claims, err := s.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if permission != "" && !claims.HasPermission(permission) {
+		return nil, errs.New(errs.KindPermissionDenied, "permission "+permission+" is required")
+	}
+	if selfOnly && (claims.UserID == "" || requestUserID(req) != claims.UserID) {
+		return nil, errs.New(errs.KindPermissionDenied, "user_id must be the authenticated user")
+	}
+	if interactiveOnly && claims.APIKeyID != "" {
+		return nil, errs.New(errs.KindPermissionDenied, "this method requires a user session and cannot be called with an API key")
+	}
+
+	ctx = context.WithValue(ctx, claimsContextKey{}, claims)
+	ctx = audit.WithActor(ctx, claimsActor(claims))
+
+	return handler(ctx, req)
+}
+
+// claimsActor 回傳 token 代表的使用者，以 client_credentials 取得的 token 則為 OAuth2 用戶端
+func claimsActor(claims *entity.Claims) string {
+	if claims.UserID != "" {
+		return claims.UserID
+	}
+
+	return claims.ClientID
+}
+
+// authenticate 驗證 authorization metadata 中的 Bearer token 或 API key 並回傳其聲明
+func (s *gRPCServer) authenticate(ctx context.Context) (*entity.Claims, error) {
+	var authorization string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			authorization = values[0]
+		}
+	}
+
+	scheme, credentials, _ := strings.Cut(authorization, " ")
+	switch {
+	case credentials == "":
+		return nil, errs.New(errs.KindInvalidCredentials, "authorization metadata is required")
+	case scheme == "ApiKey":
+		return s.apiKeyClaims(ctx, credentials)
+	case scheme != "Bearer":
+		return nil, errs.New(errs.KindInvalidCredentials, "unsupported authorization scheme")
+	}
+	tokenString := credentials
+
+	claims, err := s.parseToken(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	invalid, err := s.isTokenInvalid(ctx, claims, tokenString)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to check token validity")
+	}
+	if !invalid && claims.SessionID != "" {
+		invalid, err = s.isSessionRevoked(ctx, claims.SessionID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to check session")
+		}
+	}
+	if invalid {
+		return nil, errs.New(errs.KindInvalidCredentials, "token is invalid or has been revoked")
+	}
+
+	return claims, nil
+}
+
+// methodOptions 回傳 gRPC 方法（/package.Service/Method）的選項，找不到方法時回傳 nil
+func methodOptions(fullMethod string) proto.Message {
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(fullMethod, "/"), "/", "."))
+
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+	if err != nil {
+		return nil
+	}
+
+	method, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok || method.Options() == nil {
+		return nil
+	}
+
+	return method.Options()
+}
+
+// requestUserID 回傳請求訊息的 user_id 欄位，沒有該欄位時回傳空字串
+func requestUserID(req any) string {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return ""
+	}
+
+	reflected := msg.ProtoReflect()
+	field := reflected.Descriptor().Fields().ByName("user_id")
+	if field == nil || field.Kind() != protoreflect.StringKind {
+		return ""
+	}
+
+	return reflected.Get(field).String()
+}
+
+// accessClaims 回傳 token 需帶有的使用者角色與權限
+func (s *gRPCServer) accessClaims(ctx context.Context, userID string) ([]string, string, error) {
+	access, err := s.auth.GetUserAccess(ctx, userID)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to get user access")
+	}
+
+	return access.Roles, strings.Join(access.Permissions, " "), nil
+}
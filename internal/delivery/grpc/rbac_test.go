@@ -0,0 +1,75 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/errs"
+	"server-template/internal/domain/usecase"
+	"server-template/proto/pb/authpb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// fakeAPIKeyAuth 接受任何 API key，視為 user-1 的金鑰
+type fakeAPIKeyAuth struct {
+	usecase.AuthUseCase
+}
+
+func (fakeAPIKeyAuth) AuthenticateAPIKey(context.Context, string) (*entity.APIKeyPrincipal, error) {
+	return &entity.APIKeyPrincipal{
+		Key:         &entity.APIKey{ID: "key-1"},
+		User:        &entity.User{ID: "user-1"},
+		Permissions: []string{entity.PermissionUsersRead},
+	}, nil
+}
+
+func TestPermissionInterceptorRejectsAPIKeyOnInteractiveMethods(t *testing.T) {
+	server := &gRPCServer{auth: fakeAPIKeyAuth{}}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "ApiKey sk_test"))
+
+	tests := []struct {
+		method     string
+		req        any
+		wantDenied bool
+	}{
+		{method: authpb.Auth_CreateAPIKey_FullMethodName, req: newUserRequest(&authpb.CreateAPIKeyRequest{}), wantDenied: true},
+		{method: authpb.Auth_RevokeAPIKey_FullMethodName, req: newUserRequest(&authpb.RevokeAPIKeyRequest{}), wantDenied: true},
+		{method: authpb.Auth_EnrollMFA_FullMethodName, req: newUserRequest(&authpb.EnrollMFARequest{}), wantDenied: true},
+		{method: authpb.Auth_ConfirmMFA_FullMethodName, req: newUserRequest(&authpb.ConfirmMFARequest{}), wantDenied: true},
+		{method: authpb.Auth_DisableMFA_FullMethodName, req: newUserRequest(&authpb.DisableMFARequest{}), wantDenied: true},
+		{method: authpb.Auth_ChangePassword_FullMethodName, req: newUserRequest(&authpb.ChangePasswordRequest{}), wantDenied: true},
+		{method: authpb.Auth_ListAPIKeys_FullMethodName, req: newUserRequest(&authpb.ListAPIKeysRequest{})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			var called bool
+			_, err := server.permissionInterceptor(ctx, tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method}, func(context.Context, any) (any, error) {
+				called = true
+
+				return nil, nil
+			})
+
+			if tt.wantDenied {
+				if errs.KindOf(err) != errs.KindPermissionDenied || called {
+					t.Fatalf("error = %v, handler called = %v, want permission denied", err, called)
+				}
+
+				return
+			}
+			if err != nil || !called {
+				t.Fatalf("error = %v, handler called = %v, want the call to pass", err, called)
+			}
+		})
+	}
+}
+
+// newUserRequest 將請求的 user_id 設為 API key 所屬的使用者，讓 self_only 檢查通過
+func newUserRequest[T interface{ SetUserId(string) }](req T) T {
+	req.SetUserId("user-1")
+
+	return req
+}
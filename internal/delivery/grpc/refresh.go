@@ -0,0 +1,264 @@
+package grpc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/errs"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	defaultAccessTokenTTL  = 15 * time.Minute
+	defaultRefreshTokenTTL = 30 * 24 * time.Hour
+
+	// refreshTokenBytes 為 refresh token 的隨機位元組長度
+	refreshTokenBytes = 32
+
+	// rotateRefreshFamilyScript 以原子方式輪替 token family 目前有效的 refresh token：
+	// 若目前有效的 token 與傳入的相同則替換為新的 token 並回傳 1；
+	// 若 family 仍存在但 token 不同，表示舊 token 被重複使用，刪除整個 family 並回傳 -1；
+	// family 不存在時回傳 0。
+	rotateRefreshFamilyScript = `
+local current = redis.call("GET", KEYS[1])
+if current == ARGV[1] then
+	redis.call("SET", KEYS[1], ARGV[2], "PX", ARGV[3])
+	return 1
+end
+if current then
+	redis.call("DEL", KEYS[1])
+	return -1
+end
+return 0
+`
+)
+
+var (
+	errRefreshTokenInvalid = errors.New("refresh token is invalid or has been revoked")
+	errRefreshTokenReused  = errors.New("refresh token has already been used, token family revoked")
+)
+
+// refreshTokenRecord 為存放在 Redis 中的 refresh token 內容，
+// ClientID 為簽發對象的 OAuth2 用戶端，第一方應用程式登入取得的 refresh token 為空
+type refreshTokenRecord struct {
+	UserID   string `json:"user_id"`
+	Email    string `json:"email"`
+	FamilyID string `json:"family_id"`
+	ClientID string `json:"client_id,omitempty"`
+}
+
+func (s *gRPCServer) accessTokenTTL() time.Duration {
+	if s.cfg.Auth.AccessTokenTTL > 0 {
+		return s.cfg.Auth.AccessTokenTTL
+	}
+
+	return defaultAccessTokenTTL
+}
+
+func (s *gRPCServer) refreshTokenTTL() time.Duration {
+	if s.cfg.Auth.RefreshTokenTTL > 0 {
+		return s.cfg.Auth.RefreshTokenTTL
+	}
+
+	return defaultRefreshTokenTTL
+}
+
+// issueTokenPair 建立新的工作階段，生成 access token 並在以工作階段 ID 為名的 token family 中生成 refresh token
+func (s *gRPCServer) issueTokenPair(ctx context.Context, userID, email string, client entity.ClientInfo) (string, string, error) {
+	session, err := s.createSession(ctx, userID, client)
+	if err != nil {
+		return "", "", err
+	}
+
+	token, err := s.generateToken(ctx, userID, email, session.ID)
+	if err != nil {
+		return "", "", err
+	}
+
+	record := &refreshTokenRecord{
+		UserID:   userID,
+		Email:    email,
+		FamilyID: session.ID,
+	}
+
+	refreshToken, tokenHash, err := s.storeRefreshToken(ctx, record)
+	if err != nil {
+		return "", "", err
+	}
+
+	// 建立 token family，記錄目前唯一有效的 refresh token
+	err = s.redis.Set(ctx, refreshFamilyKey(record.FamilyID), tokenHash, s.refreshTokenTTL()).Err()
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to store refresh token family in Redis")
+	}
+
+	return token, refreshToken, nil
+}
+
+// rotateRefreshToken 以 refresh token 換取新的 access token 與 refresh token，
+// 舊的 refresh token 被重複使用時會撤銷整個 token family。
+// clientID 為已驗證的 OAuth2 用戶端，第一方應用程式為空，需與 refresh token 的簽發對象相同
+func (s *gRPCServer) rotateRefreshToken(ctx context.Context, refreshToken, clientID string) (string, string, error) {
+	record, err := s.getRefreshToken(ctx, refreshToken)
+	if err != nil {
+		return "", "", err
+	}
+	// 不洩漏 token 是否有效，與無效的 token 相同處理
+	if record.ClientID != clientID {
+		return "", "", errRefreshTokenInvalid
+	}
+
+	// 每次輪替重新載入使用者：停用或已抹除的使用者無法再換發 token，email 的變更也會反映在新的 token
+	user, err := s.auth.GetUserByID(ctx, record.UserID)
+	if errs.KindOf(err) == errs.KindNotFound {
+		return "", "", errRefreshTokenInvalid
+	}
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to get user")
+	}
+	if !user.IsActive() {
+		if err := s.revokeSession(ctx, record.UserID, record.FamilyID); err != nil {
+			return "", "", err
+		}
+
+		return "", "", errRefreshTokenInvalid
+	}
+	record.Email = user.Email
+
+	// 先寫入新的 refresh token，再切換 family 目前有效的 token
+	newRefreshToken, newHash, err := s.storeRefreshToken(ctx, record)
+	if err != nil {
+		return "", "", err
+	}
+
+	result, err := redis.NewScript(rotateRefreshFamilyScript).Run(
+		ctx,
+		s.redis,
+		[]string{refreshFamilyKey(record.FamilyID)},
+		hashRefreshToken(refreshToken),
+		newHash,
+		s.refreshTokenTTL().Milliseconds(),
+	).Int()
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to rotate refresh token family")
+	}
+
+	switch result {
+	case -1:
+		s.logger.Warn("Refresh token reuse detected, token family revoked",
+			slog.String("user_id", record.UserID),
+			slog.String("family_id", record.FamilyID),
+		)
+
+		// 同時撤銷該 token family 所屬的工作階段
+		if err := s.revokeSession(ctx, record.UserID, record.FamilyID); err != nil {
+			return "", "", err
+		}
+		s.audit.Record(ctx, &entity.AuditEvent{
+			Type:      entity.AuditEventRefreshTokenReuse,
+			SubjectID: record.UserID,
+			Outcome:   entity.AuditOutcomeFailure,
+			Reason:    errRefreshTokenReused.Error(),
+		})
+
+		return "", "", errRefreshTokenReused
+	case 0:
+		return "", "", errRefreshTokenInvalid
+	}
+
+	if err := s.extendSession(ctx, record.FamilyID); err != nil {
+		return "", "", err
+	}
+
+	token, err := s.generateToken(ctx, record.UserID, record.Email, record.FamilyID)
+	if err != nil {
+		return "", "", err
+	}
+
+	return token, newRefreshToken, nil
+}
+
+// revokeRefreshToken 撤銷 refresh token 所屬的工作階段與整個 token family
+func (s *gRPCServer) revokeRefreshToken(ctx context.Context, refreshToken string) error {
+	record, err := s.getRefreshToken(ctx, refreshToken)
+	if errors.Is(err, errRefreshTokenInvalid) {
+		// refresh token 已失效，無需撤銷
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.revokeSession(ctx, record.UserID, record.FamilyID)
+}
+
+// storeRefreshToken 生成新的 refresh token，並以其雜湊值為鍵存儲在 Redis 中
+func (s *gRPCServer) storeRefreshToken(ctx context.Context, record *refreshTokenRecord) (string, string, error) {
+	buf := make([]byte, refreshTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", errors.Wrap(err, "failed to generate refresh token")
+	}
+	refreshToken := base64.RawURLEncoding.EncodeToString(buf)
+	tokenHash := hashRefreshToken(refreshToken)
+
+	value, err := json.Marshal(record)
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to marshal refresh token")
+	}
+
+	// 使用過的 refresh token 會保留到過期，以便偵測重複使用
+	err = s.redis.Set(ctx, refreshTokenKey(tokenHash), value, s.refreshTokenTTL()).Err()
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to store refresh token in Redis")
+	}
+	if err := s.indexToken(ctx, record.UserID, refreshTokenKey(tokenHash), s.refreshTokenTTL()); err != nil {
+		return "", "", err
+	}
+
+	return refreshToken, tokenHash, nil
+}
+
+func (s *gRPCServer) getRefreshToken(ctx context.Context, refreshToken string) (*refreshTokenRecord, error) {
+	if refreshToken == "" {
+		return nil, errRefreshTokenInvalid
+	}
+
+	value, err := s.redis.Get(ctx, refreshTokenKey(hashRefreshToken(refreshToken))).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, errRefreshTokenInvalid
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get refresh token from Redis")
+	}
+
+	record := new(refreshTokenRecord)
+	if err := json.Unmarshal(value, record); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal refresh token")
+	}
+
+	return record, nil
+}
+
+func hashRefreshToken(refreshToken string) string {
+	sum := sha256.Sum256([]byte(refreshToken))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func refreshTokenKey(tokenHash string) string {
+	return fmt.Sprintf("refresh:%s", tokenHash)
+}
+
+func refreshFamilyKey(familyID string) string {
+	return fmt.Sprintf("refresh_family:%s", familyID)
+}
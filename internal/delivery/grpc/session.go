@@ -0,0 +1,238 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"time"
+
+	"server-template/internal/domain/entity"
+	"server-template/internal/infrastructure/revocation"
+	"server-template/internal/infrastructure/rpc"
+	"server-template/proto/pb/authpb"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *gRPCServer) ListSessions(ctx context.Context, in *authpb.ListSessionsRequest) (*authpb.ListSessionsResponse, error) {
+	sessions, err := s.sessions.ListByUserID(ctx, in.GetUserId())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list sessions")
+	}
+
+	pbSessions := make([]*authpb.Session, 0, len(sessions))
+	for _, session := range sessions {
+		pbSessions = append(pbSessions, newSession(session))
+	}
+
+	resp := new(authpb.ListSessionsResponse)
+	resp.SetStatus(newStatus(codes.OK, "Sessions listed successfully"))
+	resp.SetSessions(pbSessions)
+
+	return resp, nil
+}
+
+func (s *gRPCServer) RevokeSession(ctx context.Context, in *authpb.RevokeSessionRequest) (*authpb.RevokeSessionResponse, error) {
+	session, err := s.sessions.FindByID(ctx, in.GetSessionId())
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, errors.Wrap(err, "failed to find session")
+	}
+
+	// 只能撤銷屬於自己的工作階段
+	if session == nil || session.UserID != in.GetUserId() {
+		resp := new(authpb.RevokeSessionResponse)
+		resp.SetStatus(newStatus(codes.NotFound, "Session not found"))
+
+		return resp, nil
+	}
+
+	if err := s.revokeSession(ctx, session.UserID, session.ID); err != nil {
+		return nil, errors.Wrap(err, "failed to revoke session")
+	}
+	s.recordEvent(ctx, entity.AuditEventSessionRevoke, session.UserID, session.UserID)
+
+	resp := new(authpb.RevokeSessionResponse)
+	resp.SetStatus(newStatus(codes.OK, "Session revoked successfully"))
+
+	return resp, nil
+}
+
+func (s *gRPCServer) RevokeAllSessions(ctx context.Context, in *authpb.RevokeAllSessionsRequest) (*authpb.RevokeAllSessionsResponse, error) {
+	revoked, err := s.revokeAllSessions(ctx, in.GetUserId(), in.GetExceptSessionId())
+	if err != nil {
+		return nil, errors.Wrap(err, "auth.RevokeAllSessions")
+	}
+	s.recordEvent(ctx, entity.AuditEventSessionRevoke, in.GetUserId(), in.GetUserId())
+
+	resp := new(authpb.RevokeAllSessionsResponse)
+	resp.SetStatus(newStatus(codes.OK, "Sessions revoked successfully"))
+	resp.SetRevokedCount(revoked)
+
+	return resp, nil
+}
+
+// createSession 為使用者建立新的工作階段，其有效期與 refresh token 相同
+func (s *gRPCServer) createSession(ctx context.Context, userID string, client entity.ClientInfo) (*entity.Session, error) {
+	now := time.Now()
+	session := &entity.Session{
+		ID:        uuid.New().String(),
+		UserID:    userID,
+		UserAgent: client.UserAgent,
+		IPAddress: client.IPAddress,
+		IssuedAt:  now,
+		ExpiresAt: now.Add(s.refreshTokenTTL()),
+	}
+
+	if err := s.sessions.Save(ctx, session); err != nil {
+		return nil, errors.Wrap(err, "failed to create session")
+	}
+
+	return session, nil
+}
+
+// extendSession 於 refresh token 輪替後延長工作階段的有效期
+func (s *gRPCServer) extendSession(ctx context.Context, sessionID string) error {
+	session, err := s.sessions.FindByID(ctx, sessionID)
+	if errors.Is(err, redis.Nil) {
+		return errRefreshTokenInvalid
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to find session")
+	}
+
+	session.ExpiresAt = time.Now().Add(s.refreshTokenTTL())
+	if err := s.sessions.Save(ctx, session); err != nil {
+		return errors.Wrap(err, "failed to extend session")
+	}
+
+	return nil
+}
+
+// revokeSession 刪除工作階段與其 refresh token family，並通知本地撤銷快取
+func (s *gRPCServer) revokeSession(ctx context.Context, userID, sessionID string) error {
+	if err := s.sessions.Delete(ctx, userID, sessionID); err != nil {
+		return errors.Wrap(err, "failed to delete session")
+	}
+
+	if err := s.redis.Del(ctx, refreshFamilyKey(sessionID)).Err(); err != nil {
+		return errors.Wrap(err, "failed to delete refresh token family")
+	}
+
+	// 工作階段中尚未過期的 access token 最晚在一個有效期後失效
+	err := revocation.Publish(ctx, s.redis, revocation.SessionID(sessionID), time.Now().Add(s.accessTokenTTL()))
+	if err != nil {
+		s.logger.Warn("Failed to publish session revocation", slog.Any("error", err))
+		// 繼續執行，不返回錯誤
+	}
+
+	return nil
+}
+
+// revokeAllSessions 撤銷使用者除 exceptSessionID 以外的所有工作階段，回傳撤銷的數量
+func (s *gRPCServer) revokeAllSessions(ctx context.Context, userID, exceptSessionID string) (int32, error) {
+	sessions, err := s.sessions.ListByUserID(ctx, userID)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to list sessions")
+	}
+
+	var revoked int32
+	for _, session := range sessions {
+		if session.ID == exceptSessionID {
+			continue
+		}
+
+		if err := s.revokeSession(ctx, session.UserID, session.ID); err != nil {
+			return 0, errors.Wrap(err, "failed to revoke session")
+		}
+		revoked++
+	}
+
+	return revoked, nil
+}
+
+// isSessionRevoked 檢查工作階段是否已被撤銷或過期
+func (s *gRPCServer) isSessionRevoked(ctx context.Context, sessionID string) (bool, error) {
+	_, err := s.sessions.FindByID(ctx, sessionID)
+	if errors.Is(err, redis.Nil) {
+		return true, nil
+	}
+	if err != nil {
+		return false, errors.Wrap(err, "failed to find session")
+	}
+
+	return false, nil
+}
+
+// clientInfo 回傳呼叫端的用戶端資訊：受信任的呼叫端（如 HTTP 閘道）可在請求中轉送使用者的 IP 與 User-Agent，
+// 其餘呼叫端轉送的值不採用，一律取自 gRPC 連線
+func (s *gRPCServer) clientInfo(ctx context.Context, req any) entity.ClientInfo {
+	client := peerClientInfo(ctx)
+	if !s.trustsForwarder(ctx) {
+		return client
+	}
+
+	if forwarded, ok := req.(interface{ GetUserAgent() string }); ok && forwarded.GetUserAgent() != "" {
+		client.UserAgent = forwarded.GetUserAgent()
+	}
+	if forwarded, ok := req.(interface{ GetIpAddress() string }); ok && forwarded.GetIpAddress() != "" {
+		client.IPAddress = forwarded.GetIpAddress()
+	}
+
+	return client
+}
+
+// trustsForwarder 判斷呼叫端是否可轉送用戶端資訊：通過 mTLS 驗證，或連線來自 TrustedForwarders 網段
+func (s *gRPCServer) trustsForwarder(ctx context.Context) bool {
+	if _, ok := rpc.PeerIdentityFromContext(ctx); ok {
+		return true
+	}
+
+	ip := net.ParseIP(peerClientInfo(ctx).IPAddress)
+	if ip == nil {
+		return false
+	}
+	for _, ipNet := range s.trustedForwarders {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// peerClientInfo 回傳 gRPC 連線本身的用戶端資訊
+func peerClientInfo(ctx context.Context) entity.ClientInfo {
+	var client entity.ClientInfo
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("user-agent"); len(values) > 0 {
+			client.UserAgent = values[0]
+		}
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		client.IPAddress = p.Addr.String()
+		if host, _, err := net.SplitHostPort(client.IPAddress); err == nil {
+			client.IPAddress = host
+		}
+	}
+
+	return client
+}
+
+// newSession 將工作階段實體轉換為 protobuf 訊息
+func newSession(session *entity.Session) *authpb.Session {
+	pbSession := new(authpb.Session)
+	pbSession.SetId(session.ID)
+	pbSession.SetUserAgent(session.UserAgent)
+	pbSession.SetIpAddress(session.IPAddress)
+	pbSession.SetIssuedAt(timestamppb.New(session.IssuedAt))
+	pbSession.SetExpiresAt(timestamppb.New(session.ExpiresAt))
+
+	return pbSession
+}
@@ -0,0 +1,52 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+// indexToken 將 token 記錄的鍵加入擁有者的索引，抹除使用者時據以刪除其 token 記錄；
+// 索引以過期時間為分數，每次加入時清除已過期的項目
+func (s *gRPCServer) indexToken(ctx context.Context, owner, key string, ttl time.Duration) error {
+	indexKey := tokenIndexKey(owner)
+	now := time.Now()
+
+	_, err := s.redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZAdd(ctx, indexKey, redis.Z{Score: float64(now.Add(ttl).UnixMilli()), Member: key})
+		pipe.ZRemRangeByScore(ctx, indexKey, "-inf", strconv.FormatInt(now.UnixMilli(), 10))
+		// 索引至少保留到有效期最長的 refresh token 過期
+		pipe.Expire(ctx, indexKey, max(ttl, s.refreshTokenTTL()))
+
+		return nil
+	})
+
+	return errors.Wrap(err, "failed to index token")
+}
+
+// deleteOwnerTokens 刪除擁有者索引中的所有 token 記錄與索引本身
+func (s *gRPCServer) deleteOwnerTokens(ctx context.Context, owner string) error {
+	indexKey := tokenIndexKey(owner)
+
+	keys, err := s.redis.ZRange(ctx, indexKey, 0, -1).Result()
+	if err != nil {
+		return errors.Wrap(err, "failed to list indexed tokens")
+	}
+
+	// 各鍵可能位於不同的 slot，逐一刪除
+	for _, key := range keys {
+		if err := s.redis.Del(ctx, key).Err(); err != nil {
+			return errors.Wrap(err, "failed to delete token")
+		}
+	}
+
+	return errors.Wrap(s.redis.Del(ctx, indexKey).Err(), "failed to delete token index")
+}
+
+func tokenIndexKey(owner string) string {
+	return fmt.Sprintf("token_index:%s", owner)
+}
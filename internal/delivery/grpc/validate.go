@@ -0,0 +1,112 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"unicode/utf8"
+
+	"server-template/internal/domain/errs"
+	"server-template/proto/pb/authpb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// validationInterceptor 依請求欄位上的 validate 選項檢查請求，違反時回傳 VALIDATION_FAILED 與各欄位的原因
+func validationInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func streamValidationInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &serverStream{ServerStream: ss, ctx: ss.Context(), validate: true})
+}
+
+// validateRequest 檢查訊息第一層欄位的 validate 選項，非 protobuf 訊息不檢查
+func validateRequest(req any) error {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	reflectMsg := msg.ProtoReflect()
+	fields := reflectMsg.Descriptor().Fields()
+
+	var violations []errs.Violation
+	for i := range fields.Len() {
+		field := fields.Get(i)
+
+		rules, ok := proto.GetExtension(field.Options(), authpb.E_Validate).(*authpb.FieldRules)
+		if !ok || rules == nil {
+			continue
+		}
+
+		if description := validateField(reflectMsg, field, rules); description != "" {
+			violations = append(violations, errs.Violation{
+				Field:       string(field.Name()),
+				Description: description,
+			})
+		}
+	}
+
+	if len(violations) > 0 {
+		return errs.Validation("invalid request", violations...)
+	}
+
+	return nil
+}
+
+// validateField 回傳欄位違反的第一條規則，符合所有規則時回傳空字串
+func validateField(msg protoreflect.Message, field protoreflect.FieldDescriptor, rules *authpb.FieldRules) string {
+	if field.IsList() || field.IsMap() || field.Kind() != protoreflect.StringKind {
+		if rules.GetRequired() && !hasValue(msg, field) {
+			return "is required"
+		}
+
+		return ""
+	}
+
+	value := msg.Get(field).String()
+	if value == "" {
+		if rules.GetRequired() {
+			return "is required"
+		}
+
+		return ""
+	}
+
+	length := utf8.RuneCountInString(value)
+	switch {
+	case rules.GetMinLen() > 0 && length < int(rules.GetMinLen()):
+		return fmt.Sprintf("must be at least %d characters", rules.GetMinLen())
+	case rules.GetMaxLen() > 0 && length > int(rules.GetMaxLen()):
+		return fmt.Sprintf("must be at most %d characters", rules.GetMaxLen())
+	case rules.GetEmail() && !isEmail(value):
+		return "must be a valid email address"
+	default:
+		return ""
+	}
+}
+
+func hasValue(msg protoreflect.Message, field protoreflect.FieldDescriptor) bool {
+	switch {
+	case field.IsList():
+		return msg.Get(field).List().Len() > 0
+	case field.IsMap():
+		return msg.Get(field).Map().Len() > 0
+	default:
+		return msg.Has(field)
+	}
+}
+
+// isEmail 僅接受單純的 email 位址，不接受帶有顯示名稱的格式
+func isEmail(value string) bool {
+	address, err := mail.ParseAddress(value)
+
+	return err == nil && address.Address == value
+}
@@ -0,0 +1,35 @@
+package grpc
+
+import (
+	"context"
+
+	"server-template/proto/pb/authpb"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+)
+
+func (s *gRPCServer) VerifyEmail(ctx context.Context, in *authpb.VerifyEmailRequest) (*authpb.VerifyEmailResponse, error) {
+	user, err := s.auth.VerifyEmail(ctx, in.GetToken())
+	if err != nil {
+		return nil, errors.Wrap(err, "auth.VerifyEmail")
+	}
+
+	resp := new(authpb.VerifyEmailResponse)
+	resp.SetStatus(newStatus(codes.OK, "Email verified successfully"))
+	resp.SetUser(newUser(user))
+
+	return resp, nil
+}
+
+func (s *gRPCServer) ResendVerification(ctx context.Context, in *authpb.ResendVerificationRequest) (*authpb.ResendVerificationResponse, error) {
+	if err := s.auth.ResendVerification(ctx, in.GetEmail()); err != nil {
+		return nil, errors.Wrap(err, "auth.ResendVerification")
+	}
+
+	// 無論帳號是否存在都回傳相同的結果
+	resp := new(authpb.ResendVerificationResponse)
+	resp.SetStatus(newStatus(codes.OK, "If the account is pending verification, a verification link has been sent"))
+
+	return resp, nil
+}
@@ -10,10 +10,15 @@ import (
 
 	"server-template/config"
 	"server-template/internal/delivery/http/common"
+	"server-template/internal/delivery/http/middleware"
 	"server-template/internal/delivery/http/router"
 	"server-template/internal/domain/delivery"
 	"server-template/internal/domain/lifecycle"
 	"server-template/internal/domain/usecase"
+	"server-template/internal/infrastructure/idempotency"
+	"server-template/internal/infrastructure/jwtkey"
+	"server-template/internal/infrastructure/ratelimit"
+	"server-template/internal/infrastructure/revocation"
 
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
@@ -25,10 +30,15 @@ import (
 type HTTP3Params struct {
 	fx.In
 
-	Lifecycle fx.Lifecycle
-	Config    *config.Config
-	Logger    *slog.Logger
-	AuthUC    usecase.AuthHTTPUseCase
+	Lifecycle   fx.Lifecycle
+	Config      *config.Config
+	Logger      *slog.Logger
+	AuthUC      usecase.AuthHTTPUseCase
+	AdminUC     usecase.AdminHTTPUseCase
+	KeySet      *jwtkey.KeySet
+	Revocations *revocation.Cache
+	Limiter     *ratelimit.Limiter
+	Idempotency *idempotency.Store
 }
 
 type http3Server struct {
@@ -39,11 +49,22 @@ type http3Server struct {
 
 func NewHTTP3(params HTTP3Params) (delivery.Delivery, error) {
 	echoServer := echo.New()
+	ipExtractor, err := middleware.IPExtractor(params.Config.HTTP.TrustedProxies)
+	if err != nil {
+		return nil, errors.Wrap(err, "configure IP extractor")
+	}
+	echoServer.IPExtractor = ipExtractor
+
 	router.RegisterRoutes(router.RouterParams{
-		Router: echoServer,
-		Config: params.Config,
-		Logger: params.Logger,
-		AuthUC: params.AuthUC,
+		Router:      echoServer,
+		Config:      params.Config,
+		Logger:      params.Logger,
+		AuthUC:      params.AuthUC,
+		AdminUC:     params.AdminUC,
+		KeySet:      params.KeySet,
+		Revocations: params.Revocations,
+		Limiter:     params.Limiter,
+		Idempotency: params.Idempotency,
 	})
 
 	certificates, err := common.GenerateTLSConfig()
@@ -0,0 +1,245 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"server-template/internal/infrastructure/idempotency"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	HeaderIdempotencyKey     = "Idempotency-Key"
+	HeaderIdempotentReplayed = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+	idempotencyPollInterval = 100 * time.Millisecond
+)
+
+// replayedHeaders 為重播回應時一併還原的標頭，其餘標頭（如 RateLimit-*）依當次請求產生
+var replayedHeaders = []string{
+	echo.HeaderContentType,
+	echo.HeaderContentDisposition,
+	echo.HeaderLocation,
+	"Cache-Control",
+}
+
+// Idempotency 返回支援 Idempotency-Key 標頭的中間件，僅處理 POST、PUT、PATCH 與 DELETE 請求。
+// 保存期間內以相同 key 重送的請求會重播第一次的回應；key 搭配不同的請求內容時回傳 422；
+// 同一 key 的請求處理中時，重複的請求會等待其完成後重播，逾時則回傳 409。
+// 回應會保存於 Redis，只應套用於回應可保存的路由，不可用於回傳 token 或只顯示一次的憑證的端點。
+// 需置於 JWT 中間件之後，才能以使用者區分各自的 key；Redis 無法使用時不保證冪等，請求照常處理。
+func Idempotency(store *idempotency.Store, logger *slog.Logger) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			idempotencyKey := c.Request().Header.Get(HeaderIdempotencyKey)
+			if !store.Enabled() || idempotencyKey == "" || !isMutating(c.Request().Method) {
+				return next(c)
+			}
+
+			if len(idempotencyKey) > maxIdempotencyKeyLength {
+				return c.JSON(http.StatusBadRequest, map[string]string{
+					"error": "Idempotency-Key must be at most 255 characters",
+				})
+			}
+
+			body, err := io.ReadAll(c.Request().Body)
+			if err != nil {
+				return c.JSON(http.StatusBadRequest, map[string]string{
+					"error": "Invalid request body",
+				})
+			}
+			c.Request().Body = io.NopCloser(bytes.NewReader(body))
+
+			key := idempotency.Key(idempotencyScope(c), idempotencyKey)
+			fingerprint := requestFingerprint(c.Request(), body)
+
+			ctx := c.Request().Context()
+			deadline := time.Now().Add(store.LockTimeout())
+			for {
+				record, err := store.Get(ctx, key)
+				if err != nil {
+					logger.WarnContext(ctx, "Idempotency store unavailable, processing request without it", slog.Any("error", err))
+
+					return next(c)
+				}
+				if record != nil {
+					return replay(c, record, fingerprint)
+				}
+
+				token, ok, err := store.Lock(ctx, key)
+				if err != nil {
+					logger.WarnContext(ctx, "Idempotency store unavailable, processing request without it", slog.Any("error", err))
+
+					return next(c)
+				}
+				if ok {
+					return process(c, next, store, logger, key, token, fingerprint)
+				}
+
+				if time.Now().After(deadline) {
+					return c.JSON(http.StatusConflict, map[string]string{
+						"error": "A request with this Idempotency-Key is still being processed",
+					})
+				}
+
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(idempotencyPollInterval):
+				}
+			}
+		}
+	}
+}
+
+// idempotencyScope 回傳區分各呼叫端 key 的範圍：已驗證身分時為使用者，
+// 未驗證身分的端點（如登出）以 Authorization 標頭區分，匿名的請求則以用戶端 IP 區分
+func idempotencyScope(c echo.Context) string {
+	if userID, _ := c.Get("user_id").(string); userID != "" {
+		return userID
+	}
+	if authorization := c.Request().Header.Get(echo.HeaderAuthorization); authorization != "" {
+		return authorization
+	}
+
+	return "ip:" + c.RealIP()
+}
+
+// process 在持有鎖時處理請求並保存回應；伺服器錯誤與 429 不保存，讓呼叫端可以重試；
+// 標示 Cache-Control: no-store 的回應含有不應保存的內容（如憑證或個人資料），同樣不保存。
+// 處理期間持續延長鎖，處理時間超過 LockTimeout 時重複的請求會收到 409，而不是取得鎖後再處理一次
+func process(c echo.Context, next echo.HandlerFunc, store *idempotency.Store, logger *slog.Logger, key, token, fingerprint string) error {
+	ctx := context.WithoutCancel(c.Request().Context())
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		extendLock(ctx, stop, store, logger, key, token)
+	}()
+	defer func() {
+		close(stop)
+		<-done
+		if err := store.Unlock(ctx, key, token); err != nil {
+			logger.WarnContext(ctx, "Failed to release idempotency lock", slog.Any("error", err))
+		}
+	}()
+
+	writer := &captureWriter{ResponseWriter: c.Response().Writer}
+	c.Response().Writer = writer
+
+	// 回傳錯誤時回應由 echo 的錯誤處理器寫入，不在此保存
+	if err := next(c); err != nil {
+		return err
+	}
+
+	status := c.Response().Status
+	if !c.Response().Committed || status >= http.StatusInternalServerError || status == http.StatusTooManyRequests {
+		return nil
+	}
+	if c.Response().Header().Get(echo.HeaderCacheControl) == "no-store" {
+		return nil
+	}
+
+	record := &idempotency.Record{
+		Fingerprint: fingerprint,
+		StatusCode:  status,
+		Header:      make(http.Header),
+		Body:        writer.body.Bytes(),
+	}
+	for _, name := range replayedHeaders {
+		if value := c.Response().Header().Get(name); value != "" {
+			record.Header.Set(name, value)
+		}
+	}
+
+	if err := store.Save(ctx, key, record); err != nil {
+		logger.WarnContext(ctx, "Failed to save idempotency record", slog.Any("error", err))
+	}
+
+	return nil
+}
+
+// extendLock 每隔 LockTimeout 的三分之一延長一次鎖，直到 stop 關閉或鎖已不由自己持有
+func extendLock(ctx context.Context, stop <-chan struct{}, store *idempotency.Store, logger *slog.Logger, key, token string) {
+	ticker := time.NewTicker(max(store.LockTimeout()/3, time.Millisecond))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		ok, err := store.Extend(ctx, key, token)
+		if err != nil {
+			logger.WarnContext(ctx, "Failed to extend idempotency lock", slog.Any("error", err))
+
+			continue
+		}
+		if !ok {
+			logger.WarnContext(ctx, "Idempotency lock expired while the request was being processed")
+
+			return
+		}
+	}
+}
+
+// replay 重播已保存的回應，請求內容與原請求不同時回傳 422
+func replay(c echo.Context, record *idempotency.Record, fingerprint string) error {
+	if record.Fingerprint != fingerprint {
+		return c.JSON(http.StatusUnprocessableEntity, map[string]string{
+			"error": "Idempotency-Key has already been used with a different request",
+		})
+	}
+
+	header := c.Response().Header()
+	for name, values := range record.Header {
+		header[name] = values
+	}
+	header.Set(HeaderIdempotentReplayed, "true")
+
+	return c.Blob(record.StatusCode, record.Header.Get(echo.HeaderContentType), record.Body)
+}
+
+// requestFingerprint 以方法、路徑與內容識別請求，同一 key 用於不同端點時也視為不同請求
+func requestFingerprint(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(r.Method + " " + r.URL.RequestURI() + "\n"))
+	hash.Write(body)
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// captureWriter 在寫出回應的同時保留一份內容
+type captureWriter struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *captureWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *captureWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
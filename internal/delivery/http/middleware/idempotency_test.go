@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"server-template/config"
+	"server-template/internal/infrastructure/idempotency"
+	"server-template/internal/infrastructure/redistest"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestIdempotencyDoesNotStoreNoStoreResponses(t *testing.T) {
+	tests := []struct {
+		name         string
+		cacheControl string
+		wantReplayed bool
+		wantCalls    int
+	}{
+		{name: "stored response", wantReplayed: true, wantCalls: 1},
+		{name: "one-time secret", cacheControl: "no-store", wantCalls: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := new(config.Config)
+			cfg.Idempotency.Enable = true
+			store := idempotency.New(cfg, redistest.NewServer(t).Client(t))
+			logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			var calls int
+			e := echo.New()
+			e.POST("/keys", func(c echo.Context) error {
+				calls++
+				if tt.cacheControl != "" {
+					c.Response().Header().Set(echo.HeaderCacheControl, tt.cacheControl)
+				}
+
+				return c.JSON(http.StatusCreated, map[string]string{"key": "secret"})
+			}, Idempotency(store, logger))
+
+			var last *httptest.ResponseRecorder
+			for range 2 {
+				req := httptest.NewRequest(http.MethodPost, "/keys", nil)
+				req.Header.Set(HeaderIdempotencyKey, "key-1")
+				last = httptest.NewRecorder()
+				e.ServeHTTP(last, req)
+				if last.Code != http.StatusCreated {
+					t.Fatalf("status = %d, want %d", last.Code, http.StatusCreated)
+				}
+			}
+
+			record, err := store.Get(t.Context(), idempotency.Key("ip:192.0.2.1", "key-1"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if stored := record != nil; stored != tt.wantReplayed {
+				t.Errorf("response stored = %v, want %v", stored, tt.wantReplayed)
+			}
+			if replayed := last.Header().Get(HeaderIdempotentReplayed) == "true"; replayed != tt.wantReplayed {
+				t.Errorf("second response replayed = %v, want %v", replayed, tt.wantReplayed)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("handler called %d times, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
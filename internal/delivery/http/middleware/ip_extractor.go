@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"net"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+// IPExtractor 返回決定用戶端 IP 的方式：只有連線來自 trustedProxies 網段時才採用 X-Forwarded-For，
+// 未設定時一律使用連線的來源位址，避免用戶端自行帶入標頭偽造 IP
+func IPExtractor(trustedProxies []string) (echo.IPExtractor, error) {
+	if len(trustedProxies) == 0 {
+		return echo.ExtractIPDirect(), nil
+	}
+
+	// 關閉 echo 預設信任的 loopback、link-local 與私有網段，只信任設定的代理
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, cidr := range trustedProxies {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid trusted proxy %q", cidr)
+		}
+		options = append(options, echo.TrustIPRange(ipNet))
+	}
+
+	return echo.ExtractIPFromXFFHeader(options...), nil
+}
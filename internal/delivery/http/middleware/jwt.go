@@ -7,17 +7,36 @@ import (
 	"strings"
 	"time"
 
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/errs"
 	"server-template/internal/domain/usecase"
+	"server-template/internal/infrastructure/jwtkey"
+	"server-template/internal/infrastructure/revocation"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+var (
+	errTokenRevoked      = errors.New("token has been revoked")
+	errLocalUnverifiable = errors.New("token cannot be verified locally")
 )
 
 type JWTConfig struct {
 	AuthRPC usecase.AuthHTTPUseCase
 	Logger  *slog.Logger
+
+	// LocalVerification 啟用時於本地驗證簽章與有效期並查詢本地撤銷快取，
+	// 無法在本地判斷時退回呼叫 ValidateToken。
+	// 本地驗證不查詢使用者狀態，停權、抹除等需立即中止存取的操作必須撤銷使用者所有的工作階段並發佈撤銷事件，
+	// 否則已發出的 token 在過期前仍可通過驗證
+	LocalVerification bool
+	KeySet            *jwtkey.KeySet
+	Revocations       *revocation.Cache
 }
 
-// JWT 返回一個 JWT 認證中間件
+// JWT 返回一個認證中間件，接受 Bearer {token} 或供機器對機器呼叫使用的 ApiKey {key}
 func JWT(config JWTConfig) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
@@ -29,16 +48,47 @@ func JWT(config JWTConfig) echo.MiddlewareFunc {
 				})
 			}
 
-			// 檢查 Bearer 前綴
+			// 檢查 Bearer 或 ApiKey 前綴
 			parts := strings.Split(authHeader, " ")
+			if len(parts) == 2 && parts[0] == "ApiKey" {
+				return authenticateAPIKey(c, next, config, parts[1])
+			}
 			if len(parts) != 2 || parts[0] != "Bearer" {
 				return c.JSON(http.StatusUnauthorized, map[string]string{
-					"error": "Authorization header format must be Bearer {token}",
+					"error": "Authorization header format must be Bearer {token} or ApiKey {key}",
 				})
 			}
 
+			// 轉發給 Auth 服務時使用呼叫者原本的憑證
+			c.Set("authorization", authHeader)
+
 			tokenString := parts[1]
 
+			// 本地驗證
+			if config.LocalVerification {
+				claims, err := verifyLocally(config, tokenString)
+				switch {
+				case err == nil:
+					c.Set("user_id", claims.UserID)
+					c.Set("email", claims.Email)
+					c.Set("session_id", claims.SessionID)
+					c.Set("roles", claims.Roles)
+					c.Set("permissions", claims.Permissions())
+
+					return next(c)
+				case errors.Is(err, errTokenRevoked):
+					return c.JSON(http.StatusUnauthorized, map[string]string{
+						"error": "Token is invalid or has been revoked",
+					})
+				case !errors.Is(err, errLocalUnverifiable):
+					return c.JSON(http.StatusUnauthorized, map[string]string{
+						"error": "Invalid or expired token",
+					})
+				}
+
+				config.Logger.Debug("Falling back to remote token validation", slog.Any("reason", err))
+			}
+
 			// 調用 gRPC 服務
 			ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
 			defer cancel()
@@ -47,6 +97,13 @@ func JWT(config JWTConfig) echo.MiddlewareFunc {
 			if err != nil {
 				config.Logger.Error("Failed to validate token", slog.Any("error", err))
 
+				// 驗證服務暫時無法使用時不應讓用戶端誤以為 token 已失效
+				if errors.Is(err, errs.ErrUnavailable) {
+					return c.JSON(http.StatusServiceUnavailable, map[string]string{
+						"error": "Authentication service is temporarily unavailable",
+					})
+				}
+
 				return c.JSON(http.StatusUnauthorized, map[string]string{
 					"error": "Invalid or expired token",
 				})
@@ -62,8 +119,72 @@ func JWT(config JWTConfig) echo.MiddlewareFunc {
 			// 將用戶信息存儲在上下文中，以便後續處理程序使用
 			c.Set("user_id", resp.GetUser().GetId())
 			c.Set("email", resp.GetUser().GetEmail())
+			c.Set("session_id", resp.GetSessionId())
+			c.Set("roles", resp.GetRoles())
+			c.Set("permissions", resp.GetPermissions())
 
 			return next(c)
 		}
 	}
 }
+
+// authenticateAPIKey 透過 Auth 服務驗證 API key，API key 不屬於任何工作階段也不帶有角色
+func authenticateAPIKey(c echo.Context, next echo.HandlerFunc, config JWTConfig, key string) error {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	resp, err := config.AuthRPC.ValidateAPIKey(ctx, key)
+	if err != nil {
+		if errors.Is(err, errs.ErrUnavailable) {
+			config.Logger.Error("Failed to validate API key", slog.Any("error", err))
+
+			return c.JSON(http.StatusServiceUnavailable, map[string]string{
+				"error": "Authentication service is temporarily unavailable",
+			})
+		}
+
+		return c.JSON(http.StatusUnauthorized, map[string]string{
+			"error": "Invalid, expired or revoked API key",
+		})
+	}
+
+	c.Set("user_id", resp.GetUser().GetId())
+	c.Set("email", resp.GetUser().GetEmail())
+	c.Set("session_id", "")
+	c.Set("roles", []string{})
+	c.Set("permissions", resp.GetPermissions())
+	c.Set("api_key_id", resp.GetApiKeyId())
+	c.Set("authorization", "ApiKey "+key)
+
+	return next(c)
+}
+
+// verifyLocally 於本地驗證 token 的簽章、有效期與撤銷狀態，不檢查使用者是否仍為啟用狀態，
+// 停權或抹除的使用者只能依其工作階段的撤銷事件拒絕。
+// 撤銷快取尚未就緒或找不到對應的驗證金鑰時回傳 errLocalUnverifiable。
+func verifyLocally(config JWTConfig, tokenString string) (*entity.Claims, error) {
+	if config.KeySet == nil || config.Revocations == nil || !config.Revocations.Ready() {
+		return nil, errors.Wrap(errLocalUnverifiable, "revocation cache is not ready")
+	}
+
+	claims := &entity.Claims{}
+	if err := config.KeySet.Parse(tokenString, claims); err != nil {
+		if errors.Is(err, jwt.ErrTokenUnverifiable) {
+			return nil, errors.Wrap(errLocalUnverifiable, err.Error())
+		}
+
+		return nil, err
+	}
+
+	// 以 client_credentials 取得的 token 不代表任何使用者，交由 ValidateToken 判斷
+	if claims.UserID == "" {
+		return nil, errors.Wrap(errLocalUnverifiable, "token does not belong to a user")
+	}
+
+	if config.Revocations.IsRevoked(claims.TokenID(tokenString)) ||
+		(claims.SessionID != "" && config.Revocations.IsRevoked(revocation.SessionID(claims.SessionID))) {
+		return nil, errTokenRevoked
+	}
+
+	return claims, nil
+}
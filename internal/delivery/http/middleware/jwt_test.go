@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"server-template/config"
+	"server-template/internal/domain/entity"
+	"server-template/internal/infrastructure/jwtkey"
+	"server-template/internal/infrastructure/redistest"
+	"server-template/internal/infrastructure/revocation"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+	"go.uber.org/fx/fxtest"
+)
+
+// waitFor 每隔一小段時間檢查 condition，逾時則讓測試失敗
+func waitFor(t *testing.T, condition func() bool, message string) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal(message)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestVerifyLocallyRejectsRevokedSession(t *testing.T) {
+	cfg := new(config.Config)
+	cfg.Auth.JWTSecret = "test-secret"
+	cfg.Auth.LocalVerification = true
+	// 撤銷快取在訂閱後等待一個 access token 有效期才可信任，測試中不需等待
+	cfg.Auth.AccessTokenTTL = time.Nanosecond
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	client := redistest.NewServer(t).Client(t)
+
+	lc := fxtest.NewLifecycle(t)
+	keys, err := jwtkey.New(jwtkey.Params{Lifecycle: lc, Config: cfg, Logger: logger})
+	if err != nil {
+		t.Fatal(err)
+	}
+	revocations := revocation.New(revocation.Params{Lifecycle: lc, Config: cfg, Logger: logger, Redis: client})
+	lc.RequireStart()
+	t.Cleanup(lc.RequireStop)
+
+	waitFor(t, revocations.Ready, "revocation cache did not become ready")
+
+	expiresAt := time.Now().Add(time.Hour)
+	token, err := keys.Sign(&entity.Claims{
+		UserID:    "user-1",
+		SessionID: "session-1",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        "token-1",
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jwtConfig := JWTConfig{LocalVerification: true, KeySet: keys, Revocations: revocations}
+	if _, err := verifyLocally(jwtConfig, token); err != nil {
+		t.Fatalf("verifyLocally before revocation: %v", err)
+	}
+
+	// 本地驗證不檢查使用者狀態，停權與抹除時撤銷工作階段所發佈的事件是 token 失效的唯一依據
+	if err := revocation.Publish(context.Background(), client, revocation.SessionID("session-1"), expiresAt); err != nil {
+		t.Fatal(err)
+	}
+
+	waitFor(t, func() bool {
+		_, err := verifyLocally(jwtConfig, token)
+
+		return errors.Is(err, errTokenRevoked)
+	}, "token of a revoked session was still accepted locally")
+}
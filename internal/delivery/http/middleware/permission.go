@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RequirePermission 返回檢查 token 是否帶有指定權限的中間件，需置於 JWT 中間件之後
+func RequirePermission(permission string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			permissions, _ := c.Get("permissions").([]string)
+			if !slices.Contains(permissions, permission) {
+				return c.JSON(http.StatusForbidden, map[string]string{
+					"error": "Permission " + permission + " is required",
+				})
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// RequireRole 返回檢查 token 是否帶有指定角色的中間件，需置於 JWT 中間件之後
+func RequireRole(role string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			roles, _ := c.Get("roles").([]string)
+			if !slices.Contains(roles, role) {
+				return c.JSON(http.StatusForbidden, map[string]string{
+					"error": "Role " + role + " is required",
+				})
+			}
+
+			return next(c)
+		}
+	}
+}
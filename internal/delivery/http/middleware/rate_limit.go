@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"server-template/internal/infrastructure/ratelimit"
+
+	"github.com/labstack/echo/v4"
+)
+
+const rateLimitContextKey = "rate_limit"
+
+// RateLimit 返回限流中間件，keys 為此中間件負責的限流對象：
+// KeyIP 與 KeyRoute 可置於全域，KeyUser 與 KeyAPIKey 需置於 JWT 中間件之後。
+// 同一請求經過多個限流中間件時，RateLimit-* 標頭以最嚴格的結果為準。
+func RateLimit(limiter *ratelimit.Limiter, keys ...ratelimit.KeyType) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ids := make(map[ratelimit.KeyType]string, len(keys))
+			for _, key := range keys {
+				ids[key] = rateLimitID(c, key)
+			}
+
+			route := c.Request().Method + " " + c.Path()
+			result := limiter.Check(c.Request().Context(), route, ids)
+			if result == nil {
+				return next(c)
+			}
+
+			previous, _ := c.Get(rateLimitContextKey).(*ratelimit.Result)
+			if stricter := ratelimit.Stricter(previous, result); stricter != previous {
+				c.Set(rateLimitContextKey, stricter)
+				setRateLimitHeaders(c.Response().Header(), stricter)
+			}
+
+			if !result.Allowed {
+				c.Response().Header().Set(echo.HeaderRetryAfter, strconv.FormatInt(ceilSeconds(result.RetryAfter), 10))
+
+				return c.JSON(http.StatusTooManyRequests, map[string]string{
+					"error": "Rate limit exceeded",
+				})
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// rateLimitID 回傳請求對應的限流對象，無法識別時回傳空字串，該規則不套用；
+// KeyIP 由伺服器設定的 IPExtractor 決定，只有連線來自信任的代理時才採用 X-Forwarded-For
+func rateLimitID(c echo.Context, key ratelimit.KeyType) string {
+	switch key {
+	case ratelimit.KeyIP:
+		return c.RealIP()
+	case ratelimit.KeyUser:
+		userID, _ := c.Get("user_id").(string)
+
+		return userID
+	case ratelimit.KeyAPIKey:
+		apiKeyID, _ := c.Get("api_key_id").(string)
+
+		return apiKeyID
+	default:
+		return ""
+	}
+}
+
+// setRateLimitHeaders 設定 IETF RateLimit 標頭，RateLimit-Reset 為額度完全恢復所需的秒數
+func setRateLimitHeaders(header http.Header, result *ratelimit.Result) {
+	header.Set("RateLimit-Limit", strconv.Itoa(result.Limit))
+	header.Set("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+	header.Set("RateLimit-Reset", strconv.FormatInt(ceilSeconds(result.ResetAfter), 10))
+}
+
+func ceilSeconds(d time.Duration) int64 {
+	return max(int64(math.Ceil(d.Seconds())), 1)
+}
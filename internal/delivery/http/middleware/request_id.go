@@ -0,0 +1,18 @@
+package middleware
+
+import (
+	"server-template/internal/infrastructure/rpc"
+
+	"github.com/labstack/echo/v4"
+	echomiddleware "github.com/labstack/echo/v4/middleware"
+)
+
+// RequestID 返回設定 X-Request-Id 的中間件，沿用請求帶上的 ID 或產生新的 ID，
+// 並放入 request context，呼叫 gRPC 服務時以 x-request-id metadata 傳遞
+func RequestID() echo.MiddlewareFunc {
+	return echomiddleware.RequestIDWithConfig(echomiddleware.RequestIDConfig{
+		RequestIDHandler: func(c echo.Context, requestID string) {
+			c.SetRequest(c.Request().WithContext(rpc.WithRequestID(c.Request().Context(), requestID)))
+		},
+	})
+}
@@ -0,0 +1,326 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"server-template/internal/domain/entity"
+	userstatus "server-template/internal/domain/entity/user"
+	"server-template/internal/domain/errs"
+	"server-template/internal/domain/usecase"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+type AdminHandler struct {
+	adminUseCase usecase.AdminHTTPUseCase
+	logger       *slog.Logger
+}
+
+func NewAdminHandler(adminUseCase usecase.AdminHTTPUseCase, logger *slog.Logger) *AdminHandler {
+	return &AdminHandler{
+		adminUseCase: adminUseCase,
+		logger:       logger,
+	}
+}
+
+// ListUsersRequest 為列出使用者的查詢參數，時間格式為 RFC 3339
+type ListUsersRequest struct {
+	PageSize      int    `query:"page_size" validate:"omitempty,min=1,max=200"`
+	PageToken     string `query:"page_token"`
+	Status        string `query:"status"`
+	EmailPrefix   string `query:"email_prefix"`
+	CreatedAfter  string `query:"created_after"`
+	CreatedBefore string `query:"created_before"`
+}
+
+type AdminUserResponse struct {
+	UserResponse
+	MFAEnabled bool      `json:"mfa_enabled"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// ListAuditEventsRequest 為查詢稽核事件的查詢參數，時間格式為 RFC 3339
+type ListAuditEventsRequest struct {
+	PageSize      int    `query:"page_size" validate:"omitempty,min=1,max=500"`
+	PageToken     string `query:"page_token"`
+	UserID        string `query:"user_id" validate:"omitempty,uuid"`
+	CreatedAfter  string `query:"created_after"`
+	CreatedBefore string `query:"created_before"`
+}
+
+type AuditEventResponse struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	ActorID   string    `json:"actor_id,omitempty"`
+	SubjectID string    `json:"subject_id,omitempty"`
+	IPAddress string    `json:"ip_address,omitempty"`
+	UserAgent string    `json:"user_agent,omitempty"`
+	Outcome   string    `json:"outcome"`
+	Reason    string    `json:"reason,omitempty"`
+	TraceID   string    `json:"trace_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ListAuditEventsResponse struct {
+	Events        []AuditEventResponse `json:"events"`
+	NextPageToken string               `json:"next_page_token,omitempty"`
+}
+
+type ListUsersResponse struct {
+	Users         []AdminUserResponse `json:"users"`
+	NextPageToken string              `json:"next_page_token,omitempty"`
+	TotalCount    int64               `json:"total_count"`
+}
+
+// ListUsers 依建立時間由新到舊分頁列出使用者，以回應的 next_page_token 取得下一頁
+func (h *AdminHandler) ListUsers(c echo.Context) error {
+	authorization := c.Get("authorization").(string)
+
+	var req ListUsersRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Invalid request format",
+		})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	filter, err := newUserFilter(req)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	// 調用 UseCase 層
+	page, err := h.adminUseCase.ListUsers(c.Request().Context(), authorization, filter, req.PageToken, req.PageSize)
+	if err != nil {
+		h.logger.Error("Failed to list users", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
+	users := make([]AdminUserResponse, 0, len(page.Users))
+	for _, user := range page.Users {
+		users = append(users, newAdminUserResponse(user))
+	}
+
+	return c.JSON(http.StatusOK, ListUsersResponse{
+		Users:         users,
+		NextPageToken: page.NextCursor,
+		TotalCount:    page.Total,
+	})
+}
+
+// GetUser 取得指定使用者
+func (h *AdminHandler) GetUser(c echo.Context) error {
+	authorization := c.Get("authorization").(string)
+
+	// 調用 UseCase 層
+	user, err := h.adminUseCase.GetUser(c.Request().Context(), authorization, c.Param("id"))
+	if err != nil {
+		h.logger.Error("Failed to get user", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, newAdminUserResponse(user))
+}
+
+// SuspendUser 停權指定使用者並結束其所有工作階段
+func (h *AdminHandler) SuspendUser(c echo.Context) error {
+	authorization := c.Get("authorization").(string)
+
+	// 調用 UseCase 層
+	user, revoked, err := h.adminUseCase.SuspendUser(c.Request().Context(), authorization, c.Param("id"))
+	if err != nil {
+		h.logger.Error("Failed to suspend user", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"user":          newAdminUserResponse(user),
+		"revoked_count": revoked,
+	})
+}
+
+// ReactivateUser 恢復已停權的使用者
+func (h *AdminHandler) ReactivateUser(c echo.Context) error {
+	authorization := c.Get("authorization").(string)
+
+	// 調用 UseCase 層
+	user, err := h.adminUseCase.ReactivateUser(c.Request().Context(), authorization, c.Param("id"))
+	if err != nil {
+		h.logger.Error("Failed to reactivate user", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, newAdminUserResponse(user))
+}
+
+// LogoutUser 結束指定使用者所有的工作階段，強制其重新登入
+func (h *AdminHandler) LogoutUser(c echo.Context) error {
+	authorization := c.Get("authorization").(string)
+
+	// 調用 UseCase 層
+	revoked, err := h.adminUseCase.LogoutUser(c.Request().Context(), authorization, c.Param("id"))
+	if err != nil {
+		h.logger.Error("Failed to log out user", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"message":       "User logged out successfully",
+		"revoked_count": revoked,
+	})
+}
+
+// ExportUser 以 JSON 檔案下載指定使用者的所有個人資料
+func (h *AdminHandler) ExportUser(c echo.Context) error {
+	authorization := c.Get("authorization").(string)
+	userID := c.Param("id")
+
+	// 調用 UseCase 層
+	archive, err := h.adminUseCase.ExportUser(c.Request().Context(), authorization, userID)
+	if err != nil {
+		h.logger.Error("Failed to export user", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="user-`+userID+`.json"`)
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+
+	return c.Blob(http.StatusOK, echo.MIMEApplicationJSON, archive)
+}
+
+// EraseUser 匿名化指定使用者並結束其所有工作階段，無法復原
+func (h *AdminHandler) EraseUser(c echo.Context) error {
+	authorization := c.Get("authorization").(string)
+
+	// 調用 UseCase 層
+	user, revoked, err := h.adminUseCase.EraseUser(c.Request().Context(), authorization, c.Param("id"))
+	if err != nil {
+		h.logger.Error("Failed to erase user", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"user":          newAdminUserResponse(user),
+		"revoked_count": revoked,
+	})
+}
+
+// ListAuditEvents 依時間由新到舊分頁列出稽核事件，以回應的 next_page_token 取得下一頁
+func (h *AdminHandler) ListAuditEvents(c echo.Context) error {
+	authorization := c.Get("authorization").(string)
+
+	var req ListAuditEventsRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Invalid request format",
+		})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	filter := entity.AuditEventFilter{UserID: req.UserID}
+	var err error
+	if filter.CreatedAfter, err = parseTimeParam("created_after", req.CreatedAfter); err != nil {
+		return errorResponse(c, err)
+	}
+	if filter.CreatedBefore, err = parseTimeParam("created_before", req.CreatedBefore); err != nil {
+		return errorResponse(c, err)
+	}
+
+	// 調用 UseCase 層
+	page, err := h.adminUseCase.ListAuditEvents(c.Request().Context(), authorization, filter, req.PageToken, req.PageSize)
+	if err != nil {
+		h.logger.Error("Failed to list audit events", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
+	events := make([]AuditEventResponse, 0, len(page.Events))
+	for _, event := range page.Events {
+		events = append(events, newAuditEventResponse(event))
+	}
+
+	return c.JSON(http.StatusOK, ListAuditEventsResponse{
+		Events:        events,
+		NextPageToken: page.NextCursor,
+	})
+}
+
+// newUserFilter 將查詢參數轉換為篩選條件
+func newUserFilter(req ListUsersRequest) (entity.UserFilter, error) {
+	filter := entity.UserFilter{EmailPrefix: req.EmailPrefix}
+
+	if req.Status != "" {
+		status, err := userstatus.ParseUserStatus(req.Status)
+		if err != nil {
+			return filter, errs.FieldError("status", err)
+		}
+		filter.Status = status
+	}
+
+	var err error
+	if filter.CreatedAfter, err = parseTimeParam("created_after", req.CreatedAfter); err != nil {
+		return filter, err
+	}
+	if filter.CreatedBefore, err = parseTimeParam("created_before", req.CreatedBefore); err != nil {
+		return filter, err
+	}
+
+	return filter, nil
+}
+
+// parseTimeParam 解析 RFC 3339 格式的查詢參數，未提供時回傳 nil
+func parseTimeParam(field, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, errs.FieldError(field, errors.New(field+" must be an RFC 3339 timestamp"))
+	}
+
+	return &t, nil
+}
+
+func newAdminUserResponse(user *entity.User) AdminUserResponse {
+	return AdminUserResponse{
+		UserResponse: newUserResponse(user),
+		MFAEnabled:   user.MFAEnabled(),
+		UpdatedAt:    user.UpdatedAt,
+	}
+}
+
+func newAuditEventResponse(event *entity.AuditEvent) AuditEventResponse {
+	return AuditEventResponse{
+		ID:        event.ID,
+		Type:      string(event.Type),
+		ActorID:   event.ActorID,
+		SubjectID: event.SubjectID,
+		IPAddress: event.IPAddress,
+		UserAgent: event.UserAgent,
+		Outcome:   string(event.Outcome),
+		Reason:    event.Reason,
+		TraceID:   event.TraceID,
+		CreatedAt: event.CreatedAt,
+	}
+}
@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type APIKeyHandler struct {
+	authUseCase usecase.AuthHTTPUseCase
+	logger      *slog.Logger
+}
+
+func NewAPIKeyHandler(authUseCase usecase.AuthHTTPUseCase, logger *slog.Logger) *APIKeyHandler {
+	return &APIKeyHandler{
+		authUseCase: authUseCase,
+		logger:      logger,
+	}
+}
+
+type CreateAPIKeyRequest struct {
+	Name      string     `json:"name" validate:"required,max=64"`
+	Scopes    []string   `json:"scopes"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+type APIKeyResponse struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type CreateAPIKeyResponse struct {
+	APIKeyResponse
+	// Key 為完整的金鑰，只會回傳這一次
+	Key string `json:"key"`
+}
+
+type APIKeysResponse struct {
+	APIKeys []APIKeyResponse `json:"api_keys"`
+}
+
+// Create 為目前使用者建立 API key
+func (h *APIKeyHandler) Create(c echo.Context) error {
+	if rejected := rejectAPIKeyCaller(c); rejected != nil {
+		return rejected
+	}
+	authorization := c.Get("authorization").(string)
+	userID := c.Get("user_id").(string)
+
+	var req CreateAPIKeyRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Invalid request format",
+		})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	// 調用 UseCase 層
+	key, rawKey, err := h.authUseCase.CreateAPIKey(c.Request().Context(), authorization, userID, req.Name, req.Scopes, req.ExpiresAt)
+	if err != nil {
+		h.logger.Error("Failed to create API key", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
+	// 金鑰只顯示這一次
+	noStore(c)
+
+	return c.JSON(http.StatusCreated, CreateAPIKeyResponse{
+		APIKeyResponse: newAPIKeyResponse(key),
+		Key:            rawKey,
+	})
+}
+
+// List 列出目前使用者尚未撤銷的 API key
+func (h *APIKeyHandler) List(c echo.Context) error {
+	authorization := c.Get("authorization").(string)
+	userID := c.Get("user_id").(string)
+
+	// 調用 UseCase 層
+	keys, err := h.authUseCase.ListAPIKeys(c.Request().Context(), authorization, userID)
+	if err != nil {
+		h.logger.Error("Failed to list API keys", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
+	resp := APIKeysResponse{APIKeys: make([]APIKeyResponse, 0, len(keys))}
+	for _, key := range keys {
+		resp.APIKeys = append(resp.APIKeys, newAPIKeyResponse(key))
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// Revoke 撤銷目前使用者的 API key
+func (h *APIKeyHandler) Revoke(c echo.Context) error {
+	if rejected := rejectAPIKeyCaller(c); rejected != nil {
+		return rejected
+	}
+	authorization := c.Get("authorization").(string)
+	userID := c.Get("user_id").(string)
+
+	// 調用 UseCase 層
+	if err := h.authUseCase.RevokeAPIKey(c.Request().Context(), authorization, userID, c.Param("id")); err != nil {
+		h.logger.Error("Failed to revoke API key", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "API key revoked successfully",
+	})
+}
+
+func newAPIKeyResponse(key *entity.APIKey) APIKeyResponse {
+	return APIKeyResponse{
+		ID:         key.ID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Scopes:     key.ScopeList(),
+		ExpiresAt:  key.ExpiresAt,
+		LastUsedAt: key.LastUsedAt,
+		CreatedAt:  key.CreatedAt,
+	}
+}
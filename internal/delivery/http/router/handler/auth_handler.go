@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"server-template/internal/domain/entity"
 	"server-template/internal/domain/usecase"
 
 	"github.com/labstack/echo/v4"
@@ -26,7 +27,8 @@ func NewAuthHandler(authUseCase usecase.AuthHTTPUseCase, logger *slog.Logger) *A
 // Request and response structs
 type RegisterRequest struct {
 	Email    string `json:"email" validate:"required,email"`
-	Password string `json:"password" validate:"required,min=6"`
+	Password string `json:"password" validate:"required"` // 其餘規則由 Auth 服務的密碼政策檢查
+	Name     string `json:"name" validate:"max=32"`
 }
 
 type LoginRequest struct {
@@ -34,15 +36,59 @@ type LoginRequest struct {
 	Password string `json:"password" validate:"required"`
 }
 
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type ResetPasswordRequest struct {
+	Token       string `json:"token" validate:"required"`
+	NewPassword string `json:"new_password" validate:"required"`
+}
+
+type VerifyEmailRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+type ResendVerificationRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
 type UserResponse struct {
-	ID        string    `json:"id"`
-	Email     string    `json:"email"`
-	CreatedAt time.Time `json:"created_at"`
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	Email        string    `json:"email"`
+	PendingEmail *string   `json:"pending_email,omitempty"`
+	Status       string    `json:"status"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type RegisterResponse struct {
+	Message string       `json:"message"`
+	User    UserResponse `json:"user"`
 }
 
 type AuthResponse struct {
-	Token string       `json:"token"`
-	User  UserResponse `json:"user"`
+	Token        string       `json:"token"`
+	RefreshToken string       `json:"refresh_token"`
+	User         UserResponse `json:"user"`
+}
+
+type MFARequiredResponse struct {
+	MFARequired    bool   `json:"mfa_required"`
+	MFAChallengeID string `json:"mfa_challenge_id"`
+}
+
+type TokenResponse struct {
+	Token        string `json:"token"`
+	RefreshToken string `json:"refresh_token"`
 }
 
 // Register 處理用戶註冊請求
@@ -61,23 +107,17 @@ func (h *AuthHandler) Register(c echo.Context) error {
 	}
 
 	// 調用 UseCase 層
-	token, user, err := h.authUseCase.Register(c.Request().Context(), req.Email, req.Password)
+	user, err := h.authUseCase.Register(c.Request().Context(), req.Email, req.Password, req.Name)
 	if err != nil {
 		h.logger.Error("Failed to register user", slog.Any("error", err))
 
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": err.Error(),
-		})
+		return errorResponse(c, err)
 	}
 
-	// 返回用戶信息和 token
-	return c.JSON(http.StatusCreated, AuthResponse{
-		Token: token,
-		User: UserResponse{
-			ID:        user.ID,
-			Email:     user.Email,
-			CreatedAt: user.CreatedAt,
-		},
+	// 新用戶需先驗證 email 才能登入
+	return c.JSON(http.StatusCreated, RegisterResponse{
+		Message: "Registration successful, please verify your email address",
+		User:    newUserResponse(user),
 	})
 }
 
@@ -97,23 +137,30 @@ func (h *AuthHandler) Login(c echo.Context) error {
 	}
 
 	// 調用 UseCase 層
-	token, user, err := h.authUseCase.Login(c.Request().Context(), req.Email, req.Password)
+	result, err := h.authUseCase.Login(c.Request().Context(), req.Email, req.Password, clientInfo(c))
 	if err != nil {
 		h.logger.Error("Failed to login user", slog.Any("error", err))
 
-		return c.JSON(http.StatusUnauthorized, map[string]string{
-			"error": err.Error(),
+		return errorResponse(c, err)
+	}
+
+	return loginResponse(c, result)
+}
+
+// loginResponse 回傳登入結果，已啟用 MFA 的使用者需再以 challenge ID 呼叫 /auth/mfa/verify
+func loginResponse(c echo.Context, result *entity.LoginResult) error {
+	if result.MFAChallengeID != "" {
+		return c.JSON(http.StatusOK, MFARequiredResponse{
+			MFARequired:    true,
+			MFAChallengeID: result.MFAChallengeID,
 		})
 	}
 
 	// 返回用戶信息和 token
 	return c.JSON(http.StatusOK, AuthResponse{
-		Token: token,
-		User: UserResponse{
-			ID:        user.ID,
-			Email:     user.Email,
-			CreatedAt: user.CreatedAt,
-		},
+		Token:        result.Tokens.AccessToken,
+		RefreshToken: result.Tokens.RefreshToken,
+		User:         newUserResponse(result.User),
 	})
 }
 
@@ -137,17 +184,180 @@ func (h *AuthHandler) Logout(c echo.Context) error {
 
 	tokenString := parts[1]
 
+	// refresh token 為選填，提供時會一併撤銷
+	var req LogoutRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Invalid request format",
+		})
+	}
+
 	// 調用 UseCase 層
-	err := h.authUseCase.Logout(c.Request().Context(), tokenString)
+	err := h.authUseCase.Logout(c.Request().Context(), tokenString, req.RefreshToken)
 	if err != nil {
 		h.logger.Error("Failed to logout user", slog.Any("error", err))
 
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "Logged out successfully",
+	})
+}
+
+// Refresh 以 refresh token 換取新的 access token 與 refresh token
+func (h *AuthHandler) Refresh(c echo.Context) error {
+	var req RefreshRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Invalid request format",
+		})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	// 調用 UseCase 層
+	tokens, err := h.authUseCase.RefreshToken(c.Request().Context(), req.RefreshToken)
+	if err != nil {
+		h.logger.Error("Failed to refresh token", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, TokenResponse{
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	})
+}
+
+// ForgotPassword 處理忘記密碼請求，寄送重設密碼連結
+func (h *AuthHandler) ForgotPassword(c echo.Context) error {
+	var req ForgotPasswordRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Invalid request format",
+		})
+	}
+
+	if err := c.Validate(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{
 			"error": err.Error(),
 		})
 	}
 
+	// 調用 UseCase 層
+	if err := h.authUseCase.RequestPasswordReset(c.Request().Context(), req.Email); err != nil {
+		h.logger.Error("Failed to request password reset", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
+	// 無論帳號是否存在都回傳相同的結果，避免洩漏帳號資訊
+	return c.JSON(http.StatusAccepted, map[string]string{
+		"message": "If the account exists, a password reset link has been sent",
+	})
+}
+
+// ResetPassword 以重設密碼 token 設定新密碼
+func (h *AuthHandler) ResetPassword(c echo.Context) error {
+	var req ResetPasswordRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Invalid request format",
+		})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	// 調用 UseCase 層
+	if err := h.authUseCase.ResetPassword(c.Request().Context(), req.Token, req.NewPassword); err != nil {
+		h.logger.Error("Failed to reset password", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
 	return c.JSON(http.StatusOK, map[string]string{
-		"message": "Logged out successfully",
+		"message": "Password reset successfully",
 	})
 }
+
+// VerifyEmail 以 email 驗證 token 啟用帳號
+func (h *AuthHandler) VerifyEmail(c echo.Context) error {
+	var req VerifyEmailRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Invalid request format",
+		})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	// 調用 UseCase 層
+	user, err := h.authUseCase.VerifyEmail(c.Request().Context(), req.Token)
+	if err != nil {
+		h.logger.Error("Failed to verify email", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, newUserResponse(user))
+}
+
+// ResendVerification 重新寄送 email 驗證連結
+func (h *AuthHandler) ResendVerification(c echo.Context) error {
+	var req ResendVerificationRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Invalid request format",
+		})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	// 調用 UseCase 層
+	if err := h.authUseCase.ResendVerification(c.Request().Context(), req.Email); err != nil {
+		h.logger.Error("Failed to resend verification", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
+	// 無論帳號是否存在都回傳相同的結果，避免洩漏帳號資訊
+	return c.JSON(http.StatusAccepted, map[string]string{
+		"message": "If the account is pending verification, a verification link has been sent",
+	})
+}
+
+func newUserResponse(user *entity.User) UserResponse {
+	return UserResponse{
+		ID:           user.ID,
+		Name:         user.Name,
+		Email:        user.Email,
+		PendingEmail: user.PendingEmail,
+		Status:       user.Status.String(),
+		CreatedAt:    user.CreatedAt,
+	}
+}
+
+// clientInfo 取得發起請求的用戶端資訊，用於記錄工作階段
+func clientInfo(c echo.Context) entity.ClientInfo {
+	return entity.ClientInfo{
+		UserAgent: c.Request().UserAgent(),
+		IPAddress: c.RealIP(),
+	}
+}
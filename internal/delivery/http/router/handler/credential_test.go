@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"server-template/internal/delivery/http/validator"
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+// fakeCredentialUseCase 只實作回傳只顯示一次的憑證的方法
+type fakeCredentialUseCase struct {
+	usecase.AuthHTTPUseCase
+}
+
+func (fakeCredentialUseCase) CreateAPIKey(context.Context, string, string, string, []string, *time.Time) (*entity.APIKey, string, error) {
+	return &entity.APIKey{ID: "key-1"}, "sk_secret", nil
+}
+
+func (fakeCredentialUseCase) EnrollMFA(context.Context, string, string) (*entity.MFAEnrollment, error) {
+	return &entity.MFAEnrollment{Secret: "totp-secret"}, nil
+}
+
+func (fakeCredentialUseCase) ConfirmMFA(context.Context, string, string, string) ([]string, error) {
+	return []string{"recovery-code"}, nil
+}
+
+func (fakeCredentialUseCase) CreateOAuthClient(context.Context, string, string, []string, []string) (*entity.OAuthClient, string, error) {
+	return &entity.OAuthClient{ID: "client-1"}, "client-secret", nil
+}
+
+// TestOneTimeCredentialsAreNotStored 確認回傳只顯示一次的憑證的回應標示 no-store，
+// 不會被快取，也不會被 Idempotency 中間件保存
+func TestOneTimeCredentialsAreNotStored(t *testing.T) {
+	uc := fakeCredentialUseCase{}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	apiKeyHandler := NewAPIKeyHandler(uc, logger)
+	mfaHandler := NewMFAHandler(uc, logger)
+	oauth2Handler := NewOAuth2Handler(uc, "", logger)
+
+	tests := []struct {
+		name    string
+		handler echo.HandlerFunc
+		body    string
+	}{
+		{name: "API key", handler: apiKeyHandler.Create, body: `{"name":"ci"}`},
+		{name: "MFA enrollment", handler: mfaHandler.Enroll},
+		{name: "MFA recovery codes", handler: mfaHandler.Confirm, body: `{"code":"123456"}`},
+		{name: "OAuth client secret", handler: oauth2Handler.CreateClient, body: `{"name":"ci","grant_types":["client_credentials"]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Validator = validator.New()
+
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("authorization", "Bearer token")
+			c.Set("user_id", "user-1")
+
+			if err := tt.handler(c); err != nil {
+				t.Fatal(err)
+			}
+			if rec.Code >= http.StatusBadRequest {
+				t.Fatalf("status = %d, body = %s", rec.Code, rec.Body)
+			}
+			if got := rec.Header().Get(echo.HeaderCacheControl); got != "no-store" {
+				t.Errorf("Cache-Control = %q, want no-store", got)
+			}
+		})
+	}
+}
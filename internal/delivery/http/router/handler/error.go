@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/errs"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+// ErrorResponse 為錯誤回應，驗證失敗時附上各欄位的失敗原因
+type ErrorResponse struct {
+	Error      string           `json:"error"`
+	Violations []errs.Violation `json:"violations,omitempty"`
+}
+
+// errorResponse 依領域錯誤類型回傳對應的 HTTP 狀態碼，未歸類的錯誤不透露內部訊息
+func errorResponse(c echo.Context, err error) error {
+	if limited := new(entity.RateLimitedError); errors.As(err, &limited) {
+		return tooManyRequests(c, limited.RetryAfter, limited.Error())
+	}
+
+	if throttled := new(entity.LoginThrottledError); errors.As(err, &throttled) {
+		return tooManyRequests(c, throttled.RetryAfter, throttled.Error())
+	}
+
+	domainErr, ok := errs.As(err)
+	if !ok {
+		return c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "Internal server error"})
+	}
+
+	code := httpStatus(domainErr.Kind)
+	if code == http.StatusInternalServerError {
+		return c.JSON(code, ErrorResponse{Error: "Internal server error"})
+	}
+
+	return c.JSON(code, ErrorResponse{
+		Error:      domainErr.PublicMessage(),
+		Violations: domainErr.Violations,
+	})
+}
+
+// httpStatus 回傳領域錯誤類型對應的 HTTP 狀態碼
+func httpStatus(kind errs.Kind) int {
+	switch kind {
+	case errs.KindNotFound:
+		return http.StatusNotFound
+	case errs.KindAlreadyExists, errs.KindFailedPrecondition:
+		return http.StatusConflict
+	case errs.KindInvalidCredentials:
+		return http.StatusUnauthorized
+	case errs.KindValidationFailed:
+		return http.StatusBadRequest
+	case errs.KindUnavailable:
+		return http.StatusServiceUnavailable
+	case errs.KindPermissionDenied:
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// tooManyRequests 回傳 429 並以 Retry-After 告知需等待的秒數
+func tooManyRequests(c echo.Context, retryAfter time.Duration, message string) error {
+	seconds := int64(math.Ceil(retryAfter.Seconds()))
+	c.Response().Header().Set(echo.HeaderRetryAfter, strconv.FormatInt(max(seconds, 1), 10))
+
+	return c.JSON(http.StatusTooManyRequests, ErrorResponse{Error: message})
+}
+
+// rejectAPIKeyCaller 禁止以 API key 管理 API key、MFA 與登入憑證，避免外洩的金鑰自行延續權限或取得帳號控制權
+func rejectAPIKeyCaller(c echo.Context) error {
+	if keyID, _ := c.Get("api_key_id").(string); keyID == "" {
+		return nil
+	}
+
+	return c.JSON(http.StatusForbidden, map[string]string{
+		"error": "This operation requires a user session and cannot be performed with an API key",
+	})
+}
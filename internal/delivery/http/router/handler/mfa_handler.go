@@ -0,0 +1,164 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"server-template/internal/domain/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type MFAHandler struct {
+	authUseCase usecase.AuthHTTPUseCase
+	logger      *slog.Logger
+}
+
+func NewMFAHandler(authUseCase usecase.AuthHTTPUseCase, logger *slog.Logger) *MFAHandler {
+	return &MFAHandler{
+		authUseCase: authUseCase,
+		logger:      logger,
+	}
+}
+
+type VerifyMFARequest struct {
+	ChallengeID string `json:"mfa_challenge_id" validate:"required"`
+	Code        string `json:"code" validate:"required"`
+}
+
+type MFACodeRequest struct {
+	Code string `json:"code" validate:"required"`
+}
+
+type MFAEnrollmentResponse struct {
+	Secret string `json:"secret"`
+	URI    string `json:"otpauth_uri"`
+}
+
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// Verify 以 TOTP 驗證碼或復原碼完成登入
+func (h *MFAHandler) Verify(c echo.Context) error {
+	var req VerifyMFARequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Invalid request format",
+		})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	// 調用 UseCase 層
+	tokens, user, err := h.authUseCase.VerifyMFA(c.Request().Context(), req.ChallengeID, req.Code, clientInfo(c))
+	if err != nil {
+		h.logger.Error("Failed to verify MFA", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
+	// 返回用戶信息和 token
+	return c.JSON(http.StatusOK, AuthResponse{
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		User:         newUserResponse(user),
+	})
+}
+
+// Enroll 開始綁定 TOTP，回傳 secret 與 otpauth URI
+func (h *MFAHandler) Enroll(c echo.Context) error {
+	if rejected := rejectAPIKeyCaller(c); rejected != nil {
+		return rejected
+	}
+	authorization := c.Get("authorization").(string)
+	userID := c.Get("user_id").(string)
+
+	// 調用 UseCase 層
+	enrollment, err := h.authUseCase.EnrollMFA(c.Request().Context(), authorization, userID)
+	if err != nil {
+		h.logger.Error("Failed to enroll MFA", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
+	noStore(c)
+
+	return c.JSON(http.StatusOK, MFAEnrollmentResponse{
+		Secret: enrollment.Secret,
+		URI:    enrollment.URI,
+	})
+}
+
+// Confirm 以驗證碼確認綁定，回傳只會顯示一次的復原碼
+func (h *MFAHandler) Confirm(c echo.Context) error {
+	if rejected := rejectAPIKeyCaller(c); rejected != nil {
+		return rejected
+	}
+	authorization := c.Get("authorization").(string)
+	userID := c.Get("user_id").(string)
+
+	var req MFACodeRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Invalid request format",
+		})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	// 調用 UseCase 層
+	recoveryCodes, err := h.authUseCase.ConfirmMFA(c.Request().Context(), authorization, userID, req.Code)
+	if err != nil {
+		h.logger.Error("Failed to confirm MFA", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
+	noStore(c)
+
+	return c.JSON(http.StatusOK, RecoveryCodesResponse{
+		RecoveryCodes: recoveryCodes,
+	})
+}
+
+// Disable 以驗證碼或復原碼停用 MFA
+func (h *MFAHandler) Disable(c echo.Context) error {
+	if rejected := rejectAPIKeyCaller(c); rejected != nil {
+		return rejected
+	}
+	authorization := c.Get("authorization").(string)
+	userID := c.Get("user_id").(string)
+
+	var req MFACodeRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Invalid request format",
+		})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	// 調用 UseCase 層
+	if err := h.authUseCase.DisableMFA(c.Request().Context(), authorization, userID, req.Code); err != nil {
+		h.logger.Error("Failed to disable MFA", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "MFA disabled successfully",
+	})
+}
@@ -0,0 +1,390 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/errs"
+	"server-template/internal/domain/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RFC 6749 第 5.2 節定義的錯誤代碼
+const (
+	oauth2InvalidRequest       = "invalid_request"
+	oauth2InvalidClient        = "invalid_client"
+	oauth2InvalidGrant         = "invalid_grant"
+	oauth2UnauthorizedClient   = "unauthorized_client"
+	oauth2UnsupportedGrantType = "unsupported_grant_type"
+	oauth2InvalidScope         = "invalid_scope"
+	oauth2ServerError          = "server_error"
+	oauth2Unavailable          = "temporarily_unavailable"
+)
+
+// oauth2FieldErrors 為驗證失敗欄位對應的錯誤代碼，其餘欄位為 invalid_request
+var oauth2FieldErrors = map[string]string{
+	"grant_type":    oauth2UnsupportedGrantType,
+	"scope":         oauth2InvalidScope,
+	"refresh_token": oauth2InvalidGrant,
+}
+
+type OAuth2Handler struct {
+	authUseCase usecase.AuthHTTPUseCase
+	issuer      string
+	logger      *slog.Logger
+}
+
+// NewOAuth2Handler 建立 OAuth2 授權伺服器端點，issuer 為空時以請求的 host 推得
+func NewOAuth2Handler(authUseCase usecase.AuthHTTPUseCase, issuer string, logger *slog.Logger) *OAuth2Handler {
+	return &OAuth2Handler{
+		authUseCase: authUseCase,
+		issuer:      strings.TrimSuffix(issuer, "/"),
+		logger:      logger,
+	}
+}
+
+// OAuth2ClientForm 為用戶端以 client_secret_post 提供的憑證
+type OAuth2ClientForm struct {
+	ClientID     string `form:"client_id"`
+	ClientSecret string `form:"client_secret"`
+}
+
+type OAuth2TokenForm struct {
+	OAuth2ClientForm
+	GrantType    string `form:"grant_type"`
+	Scope        string `form:"scope"`
+	RefreshToken string `form:"refresh_token"`
+}
+
+type TokenForm struct {
+	OAuth2ClientForm
+	Token         string `form:"token"`
+	TokenTypeHint string `form:"token_type_hint"`
+}
+
+type OAuth2TokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+}
+
+// OAuth2ErrorResponse 為 RFC 6749 第 5.2 節定義的錯誤回應
+type OAuth2ErrorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// IntrospectionResponse 為 RFC 7662 第 2.2 節定義的回應，token 無效時只帶有 active
+type IntrospectionResponse struct {
+	Active    bool   `json:"active"`
+	Scope     string `json:"scope,omitempty"`
+	ClientID  string `json:"client_id,omitempty"`
+	Username  string `json:"username,omitempty"`
+	TokenType string `json:"token_type,omitempty"`
+	Exp       int64  `json:"exp,omitempty"`
+	Iat       int64  `json:"iat,omitempty"`
+	Sub       string `json:"sub,omitempty"`
+	Iss       string `json:"iss,omitempty"`
+	Jti       string `json:"jti,omitempty"`
+}
+
+// DiscoveryResponse 為 RFC 8414 的授權伺服器描述文件。
+// 伺服器沒有 authorization endpoint 也不簽發 ID token，response_types_supported 為空陣列，
+// 也不列出 OpenID Connect 專屬的欄位
+type DiscoveryResponse struct {
+	Issuer                                    string   `json:"issuer"`
+	TokenEndpoint                             string   `json:"token_endpoint"`
+	IntrospectionEndpoint                     string   `json:"introspection_endpoint"`
+	RevocationEndpoint                        string   `json:"revocation_endpoint"`
+	JWKSURI                                   string   `json:"jwks_uri"`
+	GrantTypesSupported                       []string `json:"grant_types_supported"`
+	ResponseTypesSupported                    []string `json:"response_types_supported"`
+	TokenEndpointAuthMethodsSupported         []string `json:"token_endpoint_auth_methods_supported"`
+	IntrospectionEndpointAuthMethodsSupported []string `json:"introspection_endpoint_auth_methods_supported"`
+	RevocationEndpointAuthMethodsSupported    []string `json:"revocation_endpoint_auth_methods_supported"`
+}
+
+type CreateOAuthClientRequest struct {
+	Name       string   `json:"name" validate:"required,max=64"`
+	Scopes     []string `json:"scopes"`
+	GrantTypes []string `json:"grant_types" validate:"required,min=1"`
+}
+
+type OAuthClientResponse struct {
+	ClientID   string   `json:"client_id"`
+	Name       string   `json:"name"`
+	Scopes     []string `json:"scopes"`
+	GrantTypes []string `json:"grant_types"`
+	CreatedAt  int64    `json:"client_id_issued_at"`
+}
+
+type CreateOAuthClientResponse struct {
+	OAuthClientResponse
+	// ClientSecret 只會回傳這一次
+	ClientSecret string `json:"client_secret"`
+}
+
+// Token 為 token endpoint（RFC 6749 第 3.2 節），支援 client_credentials 與 refresh_token grant
+func (h *OAuth2Handler) Token(c echo.Context) error {
+	var form OAuth2TokenForm
+	if err := c.Bind(&form); err != nil {
+		return oauth2Error(c, http.StatusBadRequest, oauth2InvalidRequest, "Invalid request format")
+	}
+	if form.GrantType == "" {
+		return oauth2Error(c, http.StatusBadRequest, oauth2InvalidRequest, "grant_type is required")
+	}
+
+	client, ok := clientCredentials(c, form.OAuth2ClientForm)
+	if !ok {
+		return oauth2Error(c, http.StatusBadRequest, oauth2InvalidRequest, "Only one client authentication method may be used")
+	}
+
+	// 調用 UseCase 層
+	token, err := h.authUseCase.IssueOAuth2Token(c.Request().Context(), entity.OAuth2TokenRequest{
+		GrantType:    form.GrantType,
+		Client:       client,
+		Scope:        form.Scope,
+		RefreshToken: form.RefreshToken,
+	})
+	if err != nil {
+		h.logger.Error("Failed to issue OAuth2 token", slog.Any("error", err))
+
+		return oauth2ErrorResponse(c, err)
+	}
+
+	noStore(c)
+
+	return c.JSON(http.StatusOK, OAuth2TokenResponse{
+		AccessToken:  token.AccessToken,
+		TokenType:    token.TokenType,
+		ExpiresIn:    int64(token.ExpiresIn.Seconds()),
+		RefreshToken: token.RefreshToken,
+		Scope:        token.Scope,
+	})
+}
+
+// Introspect 為 RFC 7662 token introspection endpoint，呼叫者需為已註冊的用戶端
+func (h *OAuth2Handler) Introspect(c echo.Context) error {
+	var form TokenForm
+	if err := c.Bind(&form); err != nil {
+		return oauth2Error(c, http.StatusBadRequest, oauth2InvalidRequest, "Invalid request format")
+	}
+	if form.Token == "" {
+		return oauth2Error(c, http.StatusBadRequest, oauth2InvalidRequest, "token is required")
+	}
+
+	client, ok := clientCredentials(c, form.OAuth2ClientForm)
+	if !ok {
+		return oauth2Error(c, http.StatusBadRequest, oauth2InvalidRequest, "Only one client authentication method may be used")
+	}
+
+	// 調用 UseCase 層
+	introspection, err := h.authUseCase.IntrospectToken(c.Request().Context(), client, form.Token, form.TokenTypeHint)
+	if err != nil {
+		h.logger.Error("Failed to introspect token", slog.Any("error", err))
+
+		return oauth2ErrorResponse(c, err)
+	}
+
+	noStore(c)
+
+	if !introspection.Active {
+		return c.JSON(http.StatusOK, IntrospectionResponse{Active: false})
+	}
+
+	resp := IntrospectionResponse{
+		Active:    true,
+		Scope:     introspection.Scope,
+		ClientID:  introspection.ClientID,
+		Username:  introspection.Username,
+		TokenType: introspection.TokenType,
+		Sub:       introspection.Subject,
+		Iss:       h.issuer,
+		Jti:       introspection.TokenID,
+	}
+	if !introspection.ExpiresAt.IsZero() {
+		resp.Exp = introspection.ExpiresAt.Unix()
+	}
+	if !introspection.IssuedAt.IsZero() {
+		resp.Iat = introspection.IssuedAt.Unix()
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// Revoke 為 RFC 7009 token revocation endpoint，無效的 token 也回傳 200
+func (h *OAuth2Handler) Revoke(c echo.Context) error {
+	var form TokenForm
+	if err := c.Bind(&form); err != nil {
+		return oauth2Error(c, http.StatusBadRequest, oauth2InvalidRequest, "Invalid request format")
+	}
+	if form.Token == "" {
+		return oauth2Error(c, http.StatusBadRequest, oauth2InvalidRequest, "token is required")
+	}
+
+	client, ok := clientCredentials(c, form.OAuth2ClientForm)
+	if !ok {
+		return oauth2Error(c, http.StatusBadRequest, oauth2InvalidRequest, "Only one client authentication method may be used")
+	}
+
+	// 調用 UseCase 層
+	if err := h.authUseCase.RevokeToken(c.Request().Context(), client, form.Token, form.TokenTypeHint); err != nil {
+		h.logger.Error("Failed to revoke token", slog.Any("error", err))
+
+		return oauth2ErrorResponse(c, err)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// Discovery 回傳授權伺服器的描述文件，供其他服務自動取得端點與驗證金鑰
+func (h *OAuth2Handler) Discovery(c echo.Context) error {
+	issuer := h.issuer
+	if issuer == "" {
+		issuer = c.Scheme() + "://" + c.Request().Host
+	}
+
+	authMethods := []string{"client_secret_basic", "client_secret_post"}
+
+	return c.JSON(http.StatusOK, DiscoveryResponse{
+		Issuer:                            issuer,
+		TokenEndpoint:                     issuer + "/oauth2/token",
+		IntrospectionEndpoint:             issuer + "/oauth2/introspect",
+		RevocationEndpoint:                issuer + "/oauth2/revoke",
+		JWKSURI:                           issuer + "/.well-known/jwks.json",
+		GrantTypesSupported:               []string{entity.GrantTypeClientCredentials, entity.GrantTypeRefreshToken},
+		ResponseTypesSupported:            []string{},
+		TokenEndpointAuthMethodsSupported: authMethods,
+		IntrospectionEndpointAuthMethodsSupported: authMethods,
+		RevocationEndpointAuthMethodsSupported:    authMethods,
+	})
+}
+
+// CreateClient 註冊 OAuth2 用戶端
+func (h *OAuth2Handler) CreateClient(c echo.Context) error {
+	authorization := c.Get("authorization").(string)
+
+	var req CreateOAuthClientRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Invalid request format",
+		})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	// 調用 UseCase 層
+	client, secret, err := h.authUseCase.CreateOAuthClient(c.Request().Context(), authorization, req.Name, req.Scopes, req.GrantTypes)
+	if err != nil {
+		h.logger.Error("Failed to create OAuth client", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
+	// client secret 只顯示這一次
+	noStore(c)
+
+	return c.JSON(http.StatusCreated, CreateOAuthClientResponse{
+		OAuthClientResponse: OAuthClientResponse{
+			ClientID:   client.ID,
+			Name:       client.Name,
+			Scopes:     client.ScopeList(),
+			GrantTypes: client.GrantTypeList(),
+			CreatedAt:  client.CreatedAt.Unix(),
+		},
+		ClientSecret: secret,
+	})
+}
+
+// RevokeClient 撤銷 OAuth2 用戶端
+func (h *OAuth2Handler) RevokeClient(c echo.Context) error {
+	authorization := c.Get("authorization").(string)
+
+	// 調用 UseCase 層
+	if err := h.authUseCase.RevokeOAuthClient(c.Request().Context(), authorization, c.Param("id")); err != nil {
+		h.logger.Error("Failed to revoke OAuth client", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "OAuth client revoked successfully",
+	})
+}
+
+// clientCredentials 取得以 HTTP Basic（client_secret_basic）或表單參數（client_secret_post）提供的用戶端憑證，
+// 同時使用兩種方式時回傳 false（RFC 6749 第 2.3 節）
+func clientCredentials(c echo.Context, form OAuth2ClientForm) (entity.ClientCredentials, bool) {
+	username, password, ok := c.Request().BasicAuth()
+	if !ok {
+		return entity.ClientCredentials{ClientID: form.ClientID, ClientSecret: form.ClientSecret}, true
+	}
+	if form.ClientSecret != "" {
+		return entity.ClientCredentials{}, false
+	}
+
+	// Basic 驗證的帳號密碼需先以 application/x-www-form-urlencoded 編碼（RFC 6749 第 2.3.1 節）
+	clientID, err := url.QueryUnescape(username)
+	if err != nil {
+		return entity.ClientCredentials{}, false
+	}
+	clientSecret, err := url.QueryUnescape(password)
+	if err != nil {
+		return entity.ClientCredentials{}, false
+	}
+
+	return entity.ClientCredentials{ClientID: clientID, ClientSecret: clientSecret}, true
+}
+
+// oauth2ErrorResponse 依領域錯誤類型回傳 RFC 6749 第 5.2 節的錯誤代碼
+func oauth2ErrorResponse(c echo.Context, err error) error {
+	domainErr, ok := errs.As(err)
+	if !ok {
+		return oauth2Error(c, http.StatusInternalServerError, oauth2ServerError, "")
+	}
+
+	switch domainErr.Kind {
+	case errs.KindInvalidCredentials:
+		c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Basic realm="oauth2"`)
+
+		return oauth2Error(c, http.StatusUnauthorized, oauth2InvalidClient, domainErr.PublicMessage())
+	case errs.KindPermissionDenied:
+		return oauth2Error(c, http.StatusBadRequest, oauth2UnauthorizedClient, domainErr.PublicMessage())
+	case errs.KindValidationFailed:
+		code := oauth2InvalidRequest
+		if len(domainErr.Violations) > 0 {
+			if fieldCode, ok := oauth2FieldErrors[domainErr.Violations[0].Field]; ok {
+				code = fieldCode
+			}
+		}
+
+		return oauth2Error(c, http.StatusBadRequest, code, domainErr.PublicMessage())
+	case errs.KindUnavailable:
+		return oauth2Error(c, http.StatusServiceUnavailable, oauth2Unavailable, "")
+	default:
+		return oauth2Error(c, http.StatusInternalServerError, oauth2ServerError, "")
+	}
+}
+
+func oauth2Error(c echo.Context, status int, code, description string) error {
+	noStore(c)
+
+	return c.JSON(status, OAuth2ErrorResponse{Error: code, ErrorDescription: description})
+}
+
+// noStore 禁止快取帶有 token、token 資訊或只顯示一次的憑證的回應（RFC 6749 第 5.1 節），
+// Idempotency 中間件也不會保存這些回應
+func noStore(c echo.Context) {
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	c.Response().Header().Set("Pragma", "no-cache")
+}
@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"crypto/subtle"
+	"log/slog"
+	"net/http"
+	"path"
+
+	"github.com/labstack/echo/v4"
+)
+
+// oidcStateCookieName 為保存登入 state 的 cookie，僅在登入與回呼路徑上送出
+const oidcStateCookieName = "oidc_state"
+
+type OIDCCallbackRequest struct {
+	State            string `query:"state"`
+	Code             string `query:"code"`
+	Error            string `query:"error"`
+	ErrorDescription string `query:"error_description"`
+}
+
+// OIDCLogin 將使用者導向外部身分提供者登入
+func (h *AuthHandler) OIDCLogin(c echo.Context) error {
+	// 調用 UseCase 層
+	authURL, state, err := h.authUseCase.StartOIDCLogin(c.Request().Context(), c.Param("provider"))
+	if err != nil {
+		h.logger.Error("Failed to start OIDC login", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
+	// 將 state 綁定至發起登入的瀏覽器，避免攻擊者讓受害者以攻擊者的授權碼完成登入
+	c.SetCookie(oidcStateCookie(c, state, 0))
+
+	return c.Redirect(http.StatusFound, authURL)
+}
+
+// OIDCCallback 處理外部身分提供者登入後的回呼，並發放本服務的 token
+func (h *AuthHandler) OIDCCallback(c echo.Context) error {
+	var req OIDCCallbackRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Invalid request format",
+		})
+	}
+
+	// 使用者拒絕授權或提供者回報錯誤
+	if req.Error != "" {
+		return c.JSON(http.StatusUnauthorized, map[string]string{
+			"error":             req.Error,
+			"error_description": req.ErrorDescription,
+		})
+	}
+	if req.State == "" || req.Code == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "state and code are required",
+		})
+	}
+
+	cookie, err := c.Cookie(oidcStateCookieName)
+	if err != nil || subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(req.State)) != 1 {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Login state does not match this browser, please start the login again",
+		})
+	}
+	c.SetCookie(oidcStateCookie(c, "", -1))
+
+	// 調用 UseCase 層
+	result, err := h.authUseCase.CompleteOIDCLogin(c.Request().Context(), c.Param("provider"), req.State, req.Code, clientInfo(c))
+	if err != nil {
+		h.logger.Error("Failed to complete OIDC login", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
+	return loginResponse(c, result)
+}
+
+// oidcStateCookie 建立保存 state 的 cookie，maxAge 為負數時刪除；
+// 提供者以頂層導向回呼，SameSite=Lax 的 cookie 仍會送出
+func oidcStateCookie(c echo.Context, state string, maxAge int) *http.Cookie {
+	return &http.Cookie{
+		Name:     oidcStateCookieName,
+		Value:    state,
+		Path:     path.Dir(c.Request().URL.Path),
+		MaxAge:   maxAge,
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	}
+}
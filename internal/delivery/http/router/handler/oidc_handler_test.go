@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+// fakeOIDCUseCase 只實作 OIDC 登入用到的方法，並記錄是否呼叫了 CompleteOIDCLogin
+type fakeOIDCUseCase struct {
+	usecase.AuthHTTPUseCase
+
+	state     string
+	completed bool
+}
+
+func (f *fakeOIDCUseCase) StartOIDCLogin(context.Context, string) (string, string, error) {
+	return "https://idp.example.com/authorize?state=" + f.state, f.state, nil
+}
+
+func (f *fakeOIDCUseCase) CompleteOIDCLogin(context.Context, string, string, string, entity.ClientInfo) (*entity.LoginResult, error) {
+	f.completed = true
+
+	return &entity.LoginResult{
+		Tokens: &entity.TokenPair{AccessToken: "access", RefreshToken: "refresh"},
+		User:   &entity.User{ID: "user-1"},
+	}, nil
+}
+
+func newOIDCTestServer(uc *fakeOIDCUseCase) *echo.Echo {
+	h := NewAuthHandler(uc, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	e := echo.New()
+	e.GET("/auth/oidc/:provider/login", h.OIDCLogin)
+	e.GET("/auth/oidc/:provider/callback", h.OIDCCallback)
+
+	return e
+}
+
+func TestOIDCLoginSetsStateCookie(t *testing.T) {
+	e := newOIDCTestServer(&fakeOIDCUseCase{state: "state-1"})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/auth/oidc/google/login", nil))
+
+	if rec.Code != http.StatusFound {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusFound)
+	}
+
+	cookies := rec.Result().Cookies()
+	if len(cookies) != 1 {
+		t.Fatalf("cookies = %v, want one state cookie", cookies)
+	}
+	cookie := cookies[0]
+	if cookie.Name != oidcStateCookieName || cookie.Value != "state-1" {
+		t.Errorf("cookie = %s=%s, want %s=state-1", cookie.Name, cookie.Value, oidcStateCookieName)
+	}
+	if cookie.Path != "/auth/oidc/google" || !cookie.HttpOnly || !cookie.Secure || cookie.SameSite != http.SameSiteLaxMode {
+		t.Errorf("cookie attributes = %+v", cookie)
+	}
+}
+
+func TestOIDCCallbackRequiresMatchingStateCookie(t *testing.T) {
+	tests := []struct {
+		name       string
+		cookie     string
+		wantStatus int
+	}{
+		{name: "matching cookie", cookie: "state-1", wantStatus: http.StatusOK},
+		{name: "missing cookie", wantStatus: http.StatusBadRequest},
+		{name: "cookie from another login", cookie: "state-2", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &fakeOIDCUseCase{}
+			e := newOIDCTestServer(uc)
+
+			req := httptest.NewRequest(http.MethodGet, "/auth/oidc/google/callback?state=state-1&code=code", nil)
+			if tt.cookie != "" {
+				req.AddCookie(&http.Cookie{Name: oidcStateCookieName, Value: tt.cookie})
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if uc.completed != (tt.wantStatus == http.StatusOK) {
+				t.Errorf("CompleteOIDCLogin called = %v", uc.completed)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"server-template/internal/domain/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ProfileHandler struct {
+	authUseCase usecase.AuthHTTPUseCase
+	logger      *slog.Logger
+}
+
+func NewProfileHandler(authUseCase usecase.AuthHTTPUseCase, logger *slog.Logger) *ProfileHandler {
+	return &ProfileHandler{
+		authUseCase: authUseCase,
+		logger:      logger,
+	}
+}
+
+// UpdateProfileRequest 只會變更有提供的欄位
+type UpdateProfileRequest struct {
+	Name  *string `json:"name" validate:"omitempty,max=32"`
+	Email *string `json:"email" validate:"omitempty,email"`
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" validate:"required"`
+	NewPassword     string `json:"new_password" validate:"required"` // 其餘規則由 Auth 服務的密碼政策檢查
+}
+
+// Get 取得目前使用者的個人資料
+func (h *ProfileHandler) Get(c echo.Context) error {
+	authorization := c.Get("authorization").(string)
+	userID := c.Get("user_id").(string)
+
+	// 調用 UseCase 層
+	user, err := h.authUseCase.GetProfile(c.Request().Context(), authorization, userID)
+	if err != nil {
+		h.logger.Error("Failed to get profile", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, newUserResponse(user))
+}
+
+// Update 更新目前使用者的個人資料，變更 email 需先驗證寄至新 email 的連結才會生效
+func (h *ProfileHandler) Update(c echo.Context) error {
+	authorization := c.Get("authorization").(string)
+	userID := c.Get("user_id").(string)
+
+	var req UpdateProfileRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Invalid request format",
+		})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	// email 可用於重設密碼，與密碼同樣不允許以 API key 變更
+	if req.Email != nil {
+		if rejected := rejectAPIKeyCaller(c); rejected != nil {
+			return rejected
+		}
+	}
+
+	// 調用 UseCase 層
+	user, err := h.authUseCase.UpdateProfile(c.Request().Context(), authorization, userID, req.Name, req.Email)
+	if err != nil {
+		h.logger.Error("Failed to update profile", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, newUserResponse(user))
+}
+
+// ChangePassword 驗證目前的密碼後設定新密碼，並結束目前工作階段以外的所有工作階段
+func (h *ProfileHandler) ChangePassword(c echo.Context) error {
+	if rejected := rejectAPIKeyCaller(c); rejected != nil {
+		return rejected
+	}
+	authorization := c.Get("authorization").(string)
+	userID := c.Get("user_id").(string)
+
+	var req ChangePasswordRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Invalid request format",
+		})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	// 調用 UseCase 層
+	revoked, err := h.authUseCase.ChangePassword(c.Request().Context(), authorization, userID, req.CurrentPassword, req.NewPassword)
+	if err != nil {
+		h.logger.Error("Failed to change password", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"message":       "Password changed successfully",
+		"revoked_count": revoked,
+	})
+}
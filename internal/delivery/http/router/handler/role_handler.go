@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"server-template/internal/domain/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type RoleHandler struct {
+	authUseCase usecase.AuthHTTPUseCase
+	logger      *slog.Logger
+}
+
+func NewRoleHandler(authUseCase usecase.AuthHTTPUseCase, logger *slog.Logger) *RoleHandler {
+	return &RoleHandler{
+		authUseCase: authUseCase,
+		logger:      logger,
+	}
+}
+
+type GrantRoleRequest struct {
+	Role string `json:"role" validate:"required"`
+}
+
+// Grant 將角色授予指定使用者
+func (h *RoleHandler) Grant(c echo.Context) error {
+	authorization := c.Get("authorization").(string)
+	userID := c.Param("id")
+
+	var req GrantRoleRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Invalid request format",
+		})
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	// 調用 UseCase 層
+	if err := h.authUseCase.GrantRole(c.Request().Context(), authorization, userID, req.Role); err != nil {
+		h.logger.Error("Failed to grant role", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "Role granted successfully",
+	})
+}
+
+// Revoke 移除指定使用者的角色
+func (h *RoleHandler) Revoke(c echo.Context) error {
+	authorization := c.Get("authorization").(string)
+
+	// 調用 UseCase 層
+	if err := h.authUseCase.RevokeRole(c.Request().Context(), authorization, c.Param("id"), c.Param("role")); err != nil {
+		h.logger.Error("Failed to revoke role", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "Role revoked successfully",
+	})
+}
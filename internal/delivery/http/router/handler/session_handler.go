@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"server-template/internal/domain/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type SessionHandler struct {
+	authUseCase usecase.AuthHTTPUseCase
+	logger      *slog.Logger
+}
+
+func NewSessionHandler(authUseCase usecase.AuthHTTPUseCase, logger *slog.Logger) *SessionHandler {
+	return &SessionHandler{
+		authUseCase: authUseCase,
+		logger:      logger,
+	}
+}
+
+type SessionResponse struct {
+	ID        string    `json:"id"`
+	UserAgent string    `json:"user_agent"`
+	IPAddress string    `json:"ip_address"`
+	IssuedAt  time.Time `json:"issued_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Current   bool      `json:"current"`
+}
+
+// List 列出目前使用者的所有工作階段
+func (h *SessionHandler) List(c echo.Context) error {
+	authorization := c.Get("authorization").(string)
+	userID := c.Get("user_id").(string)
+	currentSessionID, _ := c.Get("session_id").(string)
+
+	// 調用 UseCase 層
+	sessions, err := h.authUseCase.ListSessions(c.Request().Context(), authorization, userID)
+	if err != nil {
+		h.logger.Error("Failed to list sessions", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
+	resp := make([]SessionResponse, 0, len(sessions))
+	for _, session := range sessions {
+		resp = append(resp, SessionResponse{
+			ID:        session.ID,
+			UserAgent: session.UserAgent,
+			IPAddress: session.IPAddress,
+			IssuedAt:  session.IssuedAt,
+			ExpiresAt: session.ExpiresAt,
+			Current:   session.ID == currentSessionID,
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"sessions": resp,
+	})
+}
+
+// Revoke 撤銷目前使用者的指定工作階段
+func (h *SessionHandler) Revoke(c echo.Context) error {
+	authorization := c.Get("authorization").(string)
+	userID := c.Get("user_id").(string)
+
+	// 調用 UseCase 層
+	err := h.authUseCase.RevokeSession(c.Request().Context(), authorization, userID, c.Param("id"))
+	if err != nil {
+		h.logger.Error("Failed to revoke session", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "Session revoked successfully",
+	})
+}
+
+// RevokeAll 撤銷目前使用者的所有工作階段（登出所有裝置），
+// 帶有 except_current=true 時保留目前的工作階段
+func (h *SessionHandler) RevokeAll(c echo.Context) error {
+	authorization := c.Get("authorization").(string)
+	userID := c.Get("user_id").(string)
+
+	var exceptSessionID string
+	if c.QueryParam("except_current") == "true" {
+		exceptSessionID, _ = c.Get("session_id").(string)
+	}
+
+	// 調用 UseCase 層
+	revoked, err := h.authUseCase.RevokeAllSessions(c.Request().Context(), authorization, userID, exceptSessionID)
+	if err != nil {
+		h.logger.Error("Failed to revoke all sessions", slog.Any("error", err))
+
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"message":       "Sessions revoked successfully",
+		"revoked_count": revoked,
+	})
+}
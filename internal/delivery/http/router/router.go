@@ -8,7 +8,12 @@ import (
 	"server-template/internal/delivery/http/middleware"
 	"server-template/internal/delivery/http/router/handler"
 	"server-template/internal/delivery/http/validator"
+	"server-template/internal/domain/entity"
 	"server-template/internal/domain/usecase"
+	"server-template/internal/infrastructure/idempotency"
+	"server-template/internal/infrastructure/jwtkey"
+	"server-template/internal/infrastructure/ratelimit"
+	"server-template/internal/infrastructure/revocation"
 
 	"github.com/labstack/echo/v4"
 	echomiddleware "github.com/labstack/echo/v4/middleware"
@@ -17,10 +22,15 @@ import (
 
 // 也可以為 RegisterRoutes 創建一個 params 結構
 type RouterParams struct {
-	Router *echo.Echo
-	Config *config.Config
-	Logger *slog.Logger
-	AuthUC usecase.AuthHTTPUseCase
+	Router      *echo.Echo
+	Config      *config.Config
+	Logger      *slog.Logger
+	AuthUC      usecase.AuthHTTPUseCase
+	AdminUC     usecase.AdminHTTPUseCase
+	KeySet      *jwtkey.KeySet
+	Revocations *revocation.Cache
+	Limiter     *ratelimit.Limiter
+	Idempotency *idempotency.Store
 }
 
 func RegisterRoutes(params RouterParams) {
@@ -28,42 +38,115 @@ func RegisterRoutes(params RouterParams) {
 	params.Router.Validator = validator.New()
 
 	// 中間件
+	params.Router.Use(middleware.RequestID())
 	params.Router.Use(middleware.AltSvc(params.Config.HTTP.Port))
 	params.Router.Use(slogecho.New(params.Logger))
 	params.Router.Use(echomiddleware.Recover())
 	params.Router.Use(echomiddleware.CORS())
+	params.Router.Use(middleware.RateLimit(params.Limiter, ratelimit.KeyIP, ratelimit.KeyRoute))
 
 	// 基本路由
 	params.Router.GET("/ping", handlePing)
 	params.Router.GET("/protocol", handleProtocol)
 
+	// 公開驗證金鑰，供下游服務自行驗證 token
+	params.Router.GET("/.well-known/jwks.json", func(c echo.Context) error {
+		return c.JSON(http.StatusOK, params.KeySet.JWKS())
+	})
+
 	// 創建處理程序
+	oauth2Handler := handler.NewOAuth2Handler(params.AuthUC, params.Config.Auth.OAuth2.Issuer, params.Logger)
 	authHandler := handler.NewAuthHandler(params.AuthUC, params.Logger)
+	sessionHandler := handler.NewSessionHandler(params.AuthUC, params.Logger)
+	mfaHandler := handler.NewMFAHandler(params.AuthUC, params.Logger)
+	roleHandler := handler.NewRoleHandler(params.AuthUC, params.Logger)
+	apiKeyHandler := handler.NewAPIKeyHandler(params.AuthUC, params.Logger)
+	profileHandler := handler.NewProfileHandler(params.AuthUC, params.Logger)
+	adminHandler := handler.NewAdminHandler(params.AdminUC, params.Logger)
+
+	// OAuth2 授權伺服器端點，用戶端以 client_id 與 client_secret 驗證
+	// 描述文件同時提供於 RFC 8414 的路徑與既有用戶端使用的 OpenID Connect 路徑
+	params.Router.GET("/.well-known/oauth-authorization-server", oauth2Handler.Discovery)
+	params.Router.GET("/.well-known/openid-configuration", oauth2Handler.Discovery)
+	oauth2 := params.Router.Group("/oauth2")
+	oauth2.POST("/token", oauth2Handler.Token)
+	oauth2.POST("/introspect", oauth2Handler.Introspect)
+	oauth2.POST("/revoke", oauth2Handler.Revoke)
 
-	// 公開路由
+	// 帶有 Idempotency-Key 的寫入請求重送時重播第一次的回應。回應會保存於 Redis，只套用於個別列出的路由：
+	// 回傳 token 或只顯示一次的憑證（API key、MFA secret 與復原碼、client secret）的端點不套用
+	idempotent := middleware.Idempotency(params.Idempotency, params.Logger)
+
+	// 公開路由；簽發 token 或使用一次性憑證的端點不套用冪等，避免其回應保存於 Redis
 	auth := params.Router.Group("/auth")
-	auth.POST("/register", authHandler.Register)
+	auth.POST("/register", authHandler.Register, idempotent)
 	auth.POST("/login", authHandler.Login)
-	auth.POST("/logout", authHandler.Logout)
+	auth.POST("/logout", authHandler.Logout, idempotent)
+	auth.POST("/refresh", authHandler.Refresh)
+	auth.POST("/password/forgot", authHandler.ForgotPassword, idempotent)
+	auth.POST("/password/reset", authHandler.ResetPassword)
+	auth.POST("/email/verify", authHandler.VerifyEmail)
+	auth.POST("/email/resend", authHandler.ResendVerification, idempotent)
+	auth.POST("/mfa/verify", mfaHandler.Verify)
+	auth.GET("/oidc/:provider/login", authHandler.OIDCLogin)
+	auth.GET("/oidc/:provider/callback", authHandler.OIDCCallback)
 
 	// 受保護的路由
 	jwtConfig := middleware.JWTConfig{
-		AuthRPC: params.AuthUC,
-		Logger:  params.Logger,
+		AuthRPC:           params.AuthUC,
+		Logger:            params.Logger,
+		LocalVerification: params.Config.Auth.LocalVerification,
+		KeySet:            params.KeySet,
+		Revocations:       params.Revocations,
 	}
+	// 已驗證身分的請求再依使用者與 API key 限流
+	authenticatedRateLimit := middleware.RateLimit(params.Limiter, ratelimit.KeyUser, ratelimit.KeyAPIKey)
 	api := params.Router.Group("/api")
-	api.Use(middleware.JWT(jwtConfig))
+	api.Use(middleware.JWT(jwtConfig), authenticatedRateLimit)
 
-	// 示例受保護的路由
-	api.GET("/profile", func(c echo.Context) error {
-		userID := c.Get("user_id").(string)
-		email := c.Get("email").(string)
+	// 個人資料與密碼
+	api.GET("/profile", profileHandler.Get)
+	api.PATCH("/profile", profileHandler.Update, idempotent)
+	api.POST("/password", profileHandler.ChangePassword, idempotent)
 
-		return c.JSON(http.StatusOK, map[string]any{
-			"user_id": userID,
-			"email":   email,
-		})
-	})
+	// 工作階段管理
+	sessions := api.Group("/sessions")
+	sessions.GET("", sessionHandler.List)
+	sessions.DELETE("", sessionHandler.RevokeAll, idempotent)
+	sessions.DELETE("/:id", sessionHandler.Revoke, idempotent)
+
+	// MFA 綁定管理
+	mfa := api.Group("/mfa")
+	mfa.POST("/enroll", mfaHandler.Enroll)
+	mfa.POST("/confirm", mfaHandler.Confirm)
+	mfa.POST("/disable", mfaHandler.Disable, idempotent)
+
+	// API key 管理
+	keys := api.Group("/keys")
+	keys.GET("", apiKeyHandler.List)
+	keys.POST("", apiKeyHandler.Create)
+	keys.DELETE("/:id", apiKeyHandler.Revoke, idempotent)
+
+	// 角色管理
+	roles := api.Group("/users/:id/roles", middleware.RequirePermission(entity.PermissionRolesManage))
+	roles.POST("", roleHandler.Grant, idempotent)
+	roles.DELETE("/:role", roleHandler.Revoke, idempotent)
+
+	// OAuth2 用戶端管理
+	clients := api.Group("/oauth2/clients", middleware.RequirePermission(entity.PermissionClientsManage))
+	clients.POST("", oauth2Handler.CreateClient)
+	clients.DELETE("/:id", oauth2Handler.RevokeClient, idempotent)
+
+	// 管理員介面，各操作所需的權限由 Admin 服務再次檢查
+	admin := params.Router.Group("/admin", middleware.JWT(jwtConfig), authenticatedRateLimit, middleware.RequireRole(entity.RoleAdmin))
+	admin.GET("/users", adminHandler.ListUsers)
+	admin.GET("/users/:id", adminHandler.GetUser)
+	admin.POST("/users/:id/suspend", adminHandler.SuspendUser, idempotent)
+	admin.POST("/users/:id/reactivate", adminHandler.ReactivateUser, idempotent)
+	admin.POST("/users/:id/logout", adminHandler.LogoutUser, idempotent)
+	admin.GET("/users/:id/export", adminHandler.ExportUser)
+	admin.POST("/users/:id/erase", adminHandler.EraseUser, idempotent)
+	admin.GET("/audit-events", adminHandler.ListAuditEvents)
 }
 
 func handlePing(c echo.Context) error {
@@ -0,0 +1,62 @@
+package audit
+
+import (
+	"context"
+
+	"server-template/internal/domain/entity"
+)
+
+type SinkType string
+
+const (
+	SinkLog SinkType = "log"
+)
+
+func (s SinkType) IsValid() bool {
+	switch s {
+	case SinkLog:
+		return true
+	default:
+		return false
+	}
+}
+
+// Recorder 記錄稽核事件。事件先寫入 audit_events 表，再轉送至設定的次要 Sink。
+// 記錄失敗不影響呼叫端的操作，由 Recorder 自行記錄錯誤。
+type Recorder interface {
+	// Record 記錄事件，未設定的 ActorID、IPAddress、UserAgent 與 TraceID 由 ctx 補上
+	Record(ctx context.Context, event *entity.AuditEvent)
+}
+
+// Sink 為稽核事件的次要輸出，例如日誌或 SIEM
+type Sink interface {
+	Write(ctx context.Context, event *entity.AuditEvent) error
+}
+
+type actorContextKey struct{}
+
+type clientContextKey struct{}
+
+// WithActor 將執行操作的使用者或 OAuth2 用戶端放入 ctx，供 Recorder 補上事件的 ActorID
+func WithActor(ctx context.Context, actorID string) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actorID)
+}
+
+// ActorFromContext 回傳 WithActor 放入的 actor，未設定時為空
+func ActorFromContext(ctx context.Context) string {
+	actorID, _ := ctx.Value(actorContextKey{}).(string)
+
+	return actorID
+}
+
+// WithClient 將發起請求的用戶端資訊放入 ctx，供 Recorder 補上事件的 IPAddress 與 UserAgent
+func WithClient(ctx context.Context, client entity.ClientInfo) context.Context {
+	return context.WithValue(ctx, clientContextKey{}, client)
+}
+
+// ClientFromContext 回傳 WithClient 放入的用戶端資訊
+func ClientFromContext(ctx context.Context) entity.ClientInfo {
+	client, _ := ctx.Value(clientContextKey{}).(entity.ClientInfo)
+
+	return client
+}
@@ -0,0 +1,38 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+// APIKey 為供機器對機器呼叫使用的金鑰，代表擁有者以 Scopes 內的權限呼叫 API。
+// 金鑰格式為 <Prefix>.<secret>，僅存儲 secret 的雜湊值。
+type APIKey struct {
+	ID         string     `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	UserID     string     `json:"user_id" gorm:"type:uuid;index:idx_api_keys_user_id;not null"`
+	Name       string     `json:"name" gorm:"type:varchar(64);not null"`
+	Prefix     string     `json:"prefix" gorm:"type:varchar(32);uniqueIndex:idx_api_keys_prefix;not null"`
+	SecretHash string     `json:"-" gorm:"type:varchar(64);not null"`                   // SHA-256 十六進位字串
+	Scopes     string     `json:"scopes" gorm:"type:varchar(1024);not null;default:''"` // 以空白分隔的權限
+	ExpiresAt  *time.Time `json:"expires_at" gorm:"type:timestamp with time zone"`
+	LastUsedAt *time.Time `json:"last_used_at" gorm:"type:timestamp with time zone"`
+	RevokedAt  *time.Time `json:"revoked_at" gorm:"type:timestamp with time zone"`
+	CreatedAt  time.Time  `json:"created_at" gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// ScopeList 回傳金鑰被授予的權限
+func (k *APIKey) ScopeList() []string {
+	return strings.Fields(k.Scopes)
+}
+
+// IsUsable 表示金鑰在 now 時是否未撤銷且未過期
+func (k *APIKey) IsUsable(now time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
+
+// APIKeyPrincipal 為以 API key 驗證成功的呼叫者，Permissions 為金鑰權限與擁有者目前權限的交集
+type APIKeyPrincipal struct {
+	Key         *APIKey
+	User        *User
+	Permissions []string
+}
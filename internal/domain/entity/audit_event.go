@@ -0,0 +1,62 @@
+package entity
+
+import (
+	"time"
+)
+
+// AuditEventType 為稽核事件的種類，格式為 <對象>.<動作>
+type AuditEventType string
+
+const (
+	AuditEventRegister          AuditEventType = "user.register"
+	AuditEventLogin             AuditEventType = "user.login"
+	AuditEventLogout            AuditEventType = "user.logout"
+	AuditEventPasswordChange    AuditEventType = "user.password_change"
+	AuditEventPasswordReset     AuditEventType = "user.password_reset"
+	AuditEventUserSuspend       AuditEventType = "user.suspend"
+	AuditEventUserReactivate    AuditEventType = "user.reactivate"
+	AuditEventUserErase         AuditEventType = "user.erase"
+	AuditEventSessionRevoke     AuditEventType = "session.revoke"
+	AuditEventTokenRevoke       AuditEventType = "token.revoke"
+	AuditEventRefreshTokenReuse AuditEventType = "token.refresh_reuse"
+)
+
+// AuditOutcome 為稽核事件的結果
+type AuditOutcome string
+
+const (
+	AuditOutcomeSuccess AuditOutcome = "success"
+	AuditOutcomeFailure AuditOutcome = "failure"
+)
+
+// AuditEvent 為安全稽核事件，寫入後不得修改或刪除。
+// 使用者被抹除後仍保留其稽核事件，事件中不記錄 email 等可直接識別使用者的資料。
+type AuditEvent struct {
+	ID   string         `json:"id" gorm:"primaryKey;type:uuid"`
+	Type AuditEventType `json:"type" gorm:"type:varchar(64);not null"`
+	// ActorID 為執行操作的使用者或 OAuth2 用戶端，未驗證身分的操作為空
+	ActorID string `json:"actor_id" gorm:"type:varchar(64);not null;default:'';index:idx_audit_events_actor_id"`
+	// SubjectID 為受影響的使用者，無法確定時為空，例如以不存在的帳號登入
+	SubjectID string       `json:"subject_id" gorm:"type:varchar(64);not null;default:'';index:idx_audit_events_subject_id"`
+	IPAddress string       `json:"ip_address" gorm:"type:varchar(64);not null;default:''"`
+	UserAgent string       `json:"user_agent" gorm:"type:text;not null;default:''"`
+	Outcome   AuditOutcome `json:"outcome" gorm:"type:varchar(16);not null"`
+	Reason    string       `json:"reason" gorm:"type:text;not null;default:''"`
+	TraceID   string       `json:"trace_id" gorm:"type:varchar(32);not null;default:''"`
+	CreatedAt time.Time    `json:"created_at" gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP;index:idx_audit_events_created_at"`
+}
+
+// AuditEventFilter 為查詢稽核事件的篩選條件，零值的欄位不篩選
+type AuditEventFilter struct {
+	// UserID 篩選由該使用者執行或影響該使用者的事件
+	UserID string
+	// CreatedAfter 包含邊界，CreatedBefore 不包含邊界
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+}
+
+// AuditEventPage 為一頁稽核事件，NextCursor 為空時表示已無下一頁
+type AuditEventPage struct {
+	Events     []*AuditEvent
+	NextCursor string
+}
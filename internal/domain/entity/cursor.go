@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"server-template/internal/domain/errs"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// Cursor 為以 (created_at, id) 由新到舊排序的分頁位置，指向上一頁的最後一筆資料
+type Cursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+var errInvalidCursor = errs.FieldError("page_token", errors.New("page token is invalid"))
+
+// NewCursor 回傳指向 (createdAt, id) 之後的分頁位置
+func NewCursor(createdAt time.Time, id string) *Cursor {
+	return &Cursor{CreatedAt: createdAt, ID: id}
+}
+
+// Encode 將分頁位置編碼為不透明的字串
+func (c *Cursor) Encode() string {
+	raw := c.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + c.ID
+
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseCursor 解析 Encode 的輸出
+func ParseCursor(token string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	createdAt, id, ok := strings.Cut(string(raw), "|")
+	if !ok || uuid.Validate(id) != nil {
+		return nil, errInvalidCursor
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	return &Cursor{CreatedAt: t, ID: id}, nil
+}
@@ -0,0 +1,15 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// LoginThrottledError 表示登入嘗試因連續失敗而被暫時封鎖，RetryAfter 為需等待的時間
+type LoginThrottledError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LoginThrottledError) Error() string {
+	return fmt.Sprintf("too many failed login attempts, retry after %s", e.RetryAfter.Round(time.Second))
+}
@@ -0,0 +1,38 @@
+package entity
+
+import (
+	"time"
+)
+
+// MFASetting 為使用者的 TOTP 設定，以獨立資料表存放並透過 User.MFA 關聯載入
+type MFASetting struct {
+	UserID    string     `json:"user_id" gorm:"primaryKey;type:uuid"`
+	Secret    string     `json:"-" gorm:"type:varchar(64);not null"` // TOTP base32 secret
+	Enabled   bool       `json:"enabled" gorm:"type:boolean;not null;default:false"`
+	EnabledAt *time.Time `json:"enabled_at" gorm:"type:timestamp with time zone"`
+	CreatedAt time.Time  `json:"created_at" gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt time.Time  `json:"updated_at" gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP;autoUpdateTime"`
+}
+
+// MFAEnrollment 為開始綁定 TOTP 時回傳給使用者的資料，使用者以驗證器掃描 URI 後需再送出一組驗證碼確認
+type MFAEnrollment struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+// MFAChallenge 代表已通過密碼驗證、尚待 MFA 驗證的登入請求
+type MFAChallenge struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"user_id"`
+	Attempts  int       `json:"attempts"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// MFARecoveryCode 為一次性的 MFA 復原碼，僅存儲其雜湊值
+type MFARecoveryCode struct {
+	ID        string     `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	UserID    string     `json:"user_id" gorm:"type:uuid;index:idx_mfa_recovery_codes_user_id;not null"`
+	CodeHash  string     `json:"-" gorm:"type:varchar(64);not null"` // SHA-256 十六進位字串
+	UsedAt    *time.Time `json:"used_at" gorm:"type:timestamp with time zone"`
+	CreatedAt time.Time  `json:"created_at" gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+}
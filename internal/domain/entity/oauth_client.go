@@ -0,0 +1,75 @@
+package entity
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// OAuth2 授權伺服器支援的 grant type
+const (
+	GrantTypeClientCredentials = "client_credentials"
+	GrantTypeRefreshToken      = "refresh_token"
+)
+
+// OAuthClient 為向授權伺服器註冊的用戶端，以 ID 作為 client_id，僅存儲 client secret 的雜湊值
+type OAuthClient struct {
+	ID         string     `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	Name       string     `json:"name" gorm:"type:varchar(64);not null"`
+	SecretHash string     `json:"-" gorm:"type:varchar(64);not null"`                       // SHA-256 十六進位字串
+	Scopes     string     `json:"scopes" gorm:"type:varchar(1024);not null;default:''"`     // 以空白分隔、可申請的權限
+	GrantTypes string     `json:"grant_types" gorm:"type:varchar(256);not null;default:''"` // 以空白分隔、可使用的 grant type
+	RevokedAt  *time.Time `json:"revoked_at" gorm:"type:timestamp with time zone"`
+	CreatedAt  time.Time  `json:"created_at" gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// ScopeList 回傳用戶端可申請的權限
+func (c *OAuthClient) ScopeList() []string {
+	return strings.Fields(c.Scopes)
+}
+
+// GrantTypeList 回傳用戶端可使用的 grant type
+func (c *OAuthClient) GrantTypeList() []string {
+	return strings.Fields(c.GrantTypes)
+}
+
+// AllowsGrant 表示用戶端是否可使用指定的 grant type
+func (c *OAuthClient) AllowsGrant(grantType string) bool {
+	return slices.Contains(c.GrantTypeList(), grantType)
+}
+
+// ClientCredentials 為用戶端以 HTTP Basic 或表單參數提供的憑證
+type ClientCredentials struct {
+	ClientID     string
+	ClientSecret string
+}
+
+// OAuth2TokenRequest 為 token endpoint 的請求（RFC 6749 第 4.4 與第 6 節）
+type OAuth2TokenRequest struct {
+	GrantType    string
+	Client       ClientCredentials
+	Scope        string
+	RefreshToken string
+}
+
+// OAuth2Token 為 token endpoint 的回應，ExpiresIn 為 access token 剩餘有效期
+type OAuth2Token struct {
+	AccessToken  string
+	TokenType    string
+	ExpiresIn    time.Duration
+	RefreshToken string
+	Scope        string
+}
+
+// TokenIntrospection 為 token 的狀態（RFC 7662），Active 為 false 時其餘欄位皆為零值
+type TokenIntrospection struct {
+	Active    bool
+	Scope     string
+	ClientID  string
+	Username  string
+	Subject   string
+	TokenType string
+	TokenID   string
+	ExpiresAt time.Time
+	IssuedAt  time.Time
+}
@@ -0,0 +1,15 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// RateLimitedError 表示請求超過限流規則的額度，RetryAfter 為需等待的時間
+type RateLimitedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitedError) Error() string {
+	return fmt.Sprintf("rate limit exceeded, retry after %s", e.RetryAfter.Round(time.Second))
+}
@@ -0,0 +1,61 @@
+package entity
+
+import (
+	"time"
+)
+
+// 內建的權限名稱，格式為 <資源>:<動作>
+const (
+	PermissionUsersRead     = "users:read"
+	PermissionUsersWrite    = "users:write"
+	PermissionRolesManage   = "roles:manage"
+	PermissionClientsManage = "clients:manage"
+	PermissionAuditRead     = "audit:read"
+	// PermissionTokensIssue 允許為任一使用者簽發 token，只應授予內部服務，內建的管理員角色不具備此權限
+	PermissionTokensIssue = "tokens:issue"
+)
+
+// RoleAdmin 為內建的管理員角色，擁有 PermissionTokensIssue 以外的所有內建權限
+const RoleAdmin = "admin"
+
+// Role 代表一組權限的集合，使用者透過 UserRole 取得角色
+type Role struct {
+	ID          string    `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	Name        string    `json:"name" gorm:"type:varchar(64);uniqueIndex:idx_roles_name;not null"`
+	Description string    `json:"description" gorm:"type:varchar(255);not null;default:''"`
+	CreatedAt   time.Time `json:"created_at" gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt   time.Time `json:"updated_at" gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP;autoUpdateTime"`
+
+	// Permissions 為角色擁有的權限，透過 role_permissions 關聯載入
+	Permissions []*Permission `json:"permissions" gorm:"many2many:role_permissions;joinForeignKey:RoleID;joinReferences:PermissionID;-:migration"`
+}
+
+// Permission 代表可授予角色的單一權限，例如 users:read
+type Permission struct {
+	ID          string    `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	Name        string    `json:"name" gorm:"type:varchar(64);uniqueIndex:idx_permissions_name;not null"`
+	Description string    `json:"description" gorm:"type:varchar(255);not null;default:''"`
+	CreatedAt   time.Time `json:"created_at" gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// RolePermission 為角色與權限的關聯。
+// 以 ID 作為主鍵並以唯一索引限制重複關聯，因 gem 產生的 migration 不支援複合主鍵。
+type RolePermission struct {
+	ID           string `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	RoleID       string `json:"role_id" gorm:"type:uuid;uniqueIndex:idx_role_permissions_role_id_permission_id,priority:1;not null"`
+	PermissionID string `json:"permission_id" gorm:"type:uuid;uniqueIndex:idx_role_permissions_role_id_permission_id,priority:2;index:idx_role_permissions_permission_id;not null"`
+}
+
+// UserRole 為使用者與角色的關聯，主鍵設計同 RolePermission
+type UserRole struct {
+	ID        string    `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	UserID    string    `json:"user_id" gorm:"type:uuid;uniqueIndex:idx_user_roles_user_id_role_id,priority:1;not null"`
+	RoleID    string    `json:"role_id" gorm:"type:uuid;uniqueIndex:idx_user_roles_user_id_role_id,priority:2;index:idx_user_roles_role_id;not null"`
+	CreatedAt time.Time `json:"created_at" gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// Access 為使用者經由角色取得的權限，簽發 token 時寫入聲明
+type Access struct {
+	Roles       []string
+	Permissions []string
+}
@@ -0,0 +1,21 @@
+package entity
+
+import (
+	"time"
+)
+
+// Session 代表使用者的一次登入工作階段，與其 refresh token family 共用同一個 ID
+type Session struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"user_id"`
+	UserAgent string    `json:"user_agent"`
+	IPAddress string    `json:"ip_address"`
+	IssuedAt  time.Time `json:"issued_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// ClientInfo 代表發起登入請求的用戶端資訊
+type ClientInfo struct {
+	UserAgent string
+	IPAddress string
+}
@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// OneTimeTokenPurpose 為一次性 token 的用途，不同用途的 token 互不通用
+type OneTimeTokenPurpose string
+
+const (
+	OneTimeTokenPasswordReset     OneTimeTokenPurpose = "password_reset"
+	OneTimeTokenEmailVerification OneTimeTokenPurpose = "email_verification"
+	OneTimeTokenEmailChange       OneTimeTokenPurpose = "email_change"
+)
+
+// TokenPair 代表一組 access token 與 refresh token
+type TokenPair struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+// LoginResult 為登入結果；使用者已啟用 MFA 時只會帶有 MFAChallengeID，需通過 MFA 驗證才會取得 token
+type LoginResult struct {
+	Tokens         *TokenPair
+	User           *User
+	MFAChallengeID string
+}
+
+// Claims 定義 JWT 的聲明
+type Claims struct {
+	UserID    string `json:"user_id"`
+	Email     string `json:"email"`
+	SessionID string `json:"sid,omitempty"`
+	// ClientID 為以 client_credentials 取得 token 的 OAuth2 用戶端，此類 token 不屬於任何使用者
+	ClientID string `json:"client_id,omitempty"`
+	// Roles 與 Scope 為簽發時使用者擁有的角色與權限，Scope 以空白分隔（RFC 9068）
+	Roles []string `json:"roles,omitempty"`
+	Scope string   `json:"scope,omitempty"`
+	// APIKeyID 為以 API key 驗證時的 key ID，僅在服務內部使用，不會出現在 token 中
+	APIKeyID string `json:"-"`
+	jwt.RegisteredClaims
+}
+
+// Permissions 回傳 token 帶有的權限
+func (c *Claims) Permissions() []string {
+	return strings.Fields(c.Scope)
+}
+
+// HasPermission 表示 token 是否帶有指定權限
+func (c *Claims) HasPermission(permission string) bool {
+	return slices.Contains(c.Permissions(), permission)
+}
+
+// TokenID 回傳 token 狀態使用的識別值（jti）。
+// 未帶 jti 的舊 token 以其 SHA-256 雜湊值代替，避免以原始 token 作為識別值。
+func (c *Claims) TokenID(tokenString string) string {
+	if c.ID != "" {
+		return c.ID
+	}
+
+	sum := sha256.Sum256([]byte(tokenString))
+
+	return hex.EncodeToString(sum[:])
+}
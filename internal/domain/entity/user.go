@@ -5,101 +5,59 @@ import (
 	"time"
 
 	"server-template/internal/domain/entity/user"
+	"server-template/internal/domain/errs"
 
 	"github.com/pkg/errors"
-	"golang.org/x/crypto/bcrypt"
 )
 
 // User 代表使用者實體
 type User struct {
-	ID        string          `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
-	Name      string          `json:"name" gorm:"type:varchar(32);not null"`
-	Email     string          `json:"email" gorm:"type:varchar(255);uniqueIndex;not null"` // RFC 5322 標準規定 email 最大長度為 255 字元
-	Password  string          `json:"password" gorm:"type:varchar(60);not null"`           // Password 欄位使用 varchar(60) 是因為 bcrypt 使用 DefaultCost (cost=10) 時，加密後的密碼長度固定為 60 字元
-	Status    user.UserStatus `json:"status" gorm:"type:integer;not null;default:1"`
-	CreatedAt time.Time       `json:"created_at" gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
-	UpdatedAt time.Time       `json:"updated_at" gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP;autoUpdateTime"`
+	ID       string `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid();index:idx_users_created_at_id,priority:2"`
+	Name     string `json:"name" gorm:"type:varchar(32);not null"`
+	Email    string `json:"email" gorm:"type:varchar(255);uniqueIndex;not null"` // RFC 5322 標準規定 email 最大長度為 255 字元
+	Password string `json:"-" gorm:"type:varchar(255);not null"`                 // PHC 格式的密碼雜湊值，長度依演算法與參數而定
+	// PendingEmail 為申請變更、尚待驗證的新 email，驗證後才會取代 Email
+	PendingEmail *string         `json:"pending_email" gorm:"type:varchar(255)"`
+	Status       user.UserStatus `json:"status" gorm:"type:integer;not null;default:1"`
+	CreatedAt    time.Time       `json:"created_at" gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP;index:idx_users_created_at_id,priority:1"` // 管理介面以 (created_at, id) 分頁
+	UpdatedAt    time.Time       `json:"updated_at" gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP;autoUpdateTime"`
+
+	// MFA 為使用者的 TOTP 設定，未綁定時為 nil
+	MFA *MFASetting `json:"-" gorm:"foreignKey:UserID;-:migration"`
 }
 
 func (u *User) Validate() error {
 	if u.Email == "" {
-		return errors.New("email is required")
+		return errs.FieldError("email", errors.New("email is required"))
 	}
 
 	if _, err := mail.ParseAddress(u.Email); err != nil {
-		return errors.New("invalid email format")
+		return errs.FieldError("email", errors.New("invalid email format"))
 	}
 
 	if len(u.Name) > 32 {
-		return errors.New("name must be less than 32 characters")
-	}
-
-	return u.validatePassword()
-}
-
-func (u *User) validatePassword() error {
-	if len(u.Password) < 8 {
-		return errors.New("password must be at least 8 characters long")
-	}
-	if len(u.Password) > 128 {
-		return errors.New("password must be less than 128 characters")
-	}
-
-	return u.validatePasswordComplexity()
-}
-
-func (u *User) validatePasswordComplexity() error {
-	var (
-		hasUpper   bool
-		hasLower   bool
-		hasNumber  bool
-		hasSpecial bool
-	)
-
-	for _, char := range u.Password {
-		hasUpper = hasUpper || isUpperCase(char)
-		hasLower = hasLower || isLowerCase(char)
-		hasNumber = hasNumber || isNumber(char)
-		hasSpecial = hasSpecial || isSpecialChar(char)
-	}
-
-	if !hasUpper || !hasLower || !hasNumber || !hasSpecial {
-		return errors.New("password must contain at least one uppercase letter, one lowercase letter, one number, and one special character")
+		return errs.FieldError("name", errors.New("name must be less than 32 characters"))
 	}
 
 	return nil
 }
 
-func isUpperCase(char rune) bool {
-	return char >= 'A' && char <= 'Z'
-}
-
-func isLowerCase(char rune) bool {
-	return char >= 'a' && char <= 'z'
-}
-
-func isNumber(char rune) bool {
-	return char >= '0' && char <= '9'
+// IsActive 表示使用者是否可以登入與使用 token
+func (u *User) IsActive() bool {
+	return u.Status == user.UserStatusActive
 }
 
-// isSpecialChar 檢查是否為特殊符號，包含以下範圍：
-// - ! " # $ % & ' ( ) * + , - . /  (ASCII 33-47)
-// - : ; < = > ? @                  (ASCII 58-64)
-// - [ \ ] ^ _ `                    (ASCII 91-96)
-// - { | } ~                        (ASCII 123-126)
-func isSpecialChar(char rune) bool {
-	return (char >= '!' && char <= '/') ||
-		(char >= ':' && char <= '@') ||
-		(char >= '[' && char <= '`') ||
-		(char >= '{' && char <= '~')
+// MFAEnabled 表示使用者是否已完成 TOTP 綁定，登入時需通過 MFA 驗證
+func (u *User) MFAEnabled() bool {
+	return u.MFA != nil && u.MFA.Enabled
 }
 
-func (u *User) HashPassword() error {
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(u.Password), bcrypt.DefaultCost)
-	if err != nil {
-		return errors.Wrap(err, "failed to hash password")
+// TransitionTo 將使用者狀態變更為 status，不合法的轉換會回傳 user.ErrInvalidStatusTransition
+func (u *User) TransitionTo(status user.UserStatus) error {
+	if !u.Status.CanTransitionTo(status) {
+		return errors.Wrapf(user.ErrInvalidStatusTransition, "cannot change status from %s to %s", u.Status, status)
 	}
-	u.Password = string(hashedPassword)
+	u.Status = status
 
 	return nil
 }
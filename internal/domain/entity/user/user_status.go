@@ -1,8 +1,69 @@
 package user
 
+import "github.com/pkg/errors"
+
+// UserStatus 為使用者狀態，數值會存入資料庫，新增狀態時只能附加於最後。
+// 資料庫欄位預設值為 1（active），既有使用者皆視為已啟用；新註冊的使用者須明確寫入 pending_verification。
 type UserStatus int
 
 const (
-	UserStatusActive UserStatus = iota
-	UserStatusInactive
+	// UserStatusActive 可正常登入
+	UserStatusActive UserStatus = iota + 1
+	// UserStatusPendingVerification 已註冊但尚未驗證 email
+	UserStatusPendingVerification
+	// UserStatusSuspended 已被停權
+	UserStatusSuspended
+	// UserStatusDeleted 已刪除，為最終狀態
+	UserStatusDeleted
 )
+
+var ErrInvalidStatusTransition = errors.New("invalid user status transition")
+
+func (s UserStatus) String() string {
+	switch s {
+	case UserStatusPendingVerification:
+		return "pending_verification"
+	case UserStatusActive:
+		return "active"
+	case UserStatusSuspended:
+		return "suspended"
+	case UserStatusDeleted:
+		return "deleted"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseUserStatus 將 String 的輸出轉回 UserStatus
+func ParseUserStatus(status string) (UserStatus, error) {
+	for s := UserStatusActive; s <= UserStatusDeleted; s++ {
+		if s.String() == status {
+			return s, nil
+		}
+	}
+
+	return 0, errors.Errorf("unknown user status %q", status)
+}
+
+// IsValid 檢查狀態是否為已定義的值
+func (s UserStatus) IsValid() bool {
+	return s >= UserStatusActive && s <= UserStatusDeleted
+}
+
+// CanTransitionTo 檢查是否允許由目前狀態轉換為 next：
+//   - pending_verification → active, deleted
+//   - active → suspended, deleted
+//   - suspended → active, deleted
+//   - deleted 不可再轉換
+func (s UserStatus) CanTransitionTo(next UserStatus) bool {
+	switch s {
+	case UserStatusPendingVerification:
+		return next == UserStatusActive || next == UserStatusDeleted
+	case UserStatusActive:
+		return next == UserStatusSuspended || next == UserStatusDeleted
+	case UserStatusSuspended:
+		return next == UserStatusActive || next == UserStatusDeleted
+	default:
+		return false
+	}
+}
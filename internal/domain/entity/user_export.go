@@ -0,0 +1,25 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// UserExport 為匯出的使用者個人資料，依 GDPR 資料可攜權提供給使用者。
+// 密碼、金鑰等憑證僅存有雜湊值，不會匯出。
+type UserExport struct {
+	ExportedAt time.Time       `json:"exported_at"`
+	User       *User           `json:"user"`
+	MFAEnabled bool            `json:"mfa_enabled"`
+	Roles      []string        `json:"roles"`
+	APIKeys    []*APIKey       `json:"api_keys"`
+	Identities []*UserIdentity `json:"identities"`
+	Sessions   []*Session      `json:"sessions"`
+	// AuditEvents 為由使用者執行或影響使用者的稽核事件
+	AuditEvents []*AuditEvent `json:"audit_events"`
+}
+
+// ErasedEmail 回傳使用者被抹除後使用的 email，保留唯一性但不含任何個人資料
+func ErasedEmail(userID string) string {
+	return fmt.Sprintf("erased-%s@erased.invalid", userID)
+}
@@ -0,0 +1,24 @@
+package entity
+
+import (
+	"time"
+)
+
+// UserIdentity 為連結至使用者的外部身分，以提供者名稱與其 subject 唯一識別
+type UserIdentity struct {
+	ID          string    `json:"id" gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	UserID      string    `json:"user_id" gorm:"type:uuid;index:idx_user_identities_user_id;not null"`
+	Provider    string    `json:"provider" gorm:"type:varchar(64);uniqueIndex:idx_user_identities_provider_subject,priority:1;not null"`
+	Subject     string    `json:"subject" gorm:"type:varchar(255);uniqueIndex:idx_user_identities_provider_subject,priority:2;not null"`
+	Email       string    `json:"email" gorm:"type:varchar(255);not null;default:''"`
+	CreatedAt   time.Time `json:"created_at" gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+	LastLoginAt time.Time `json:"last_login_at" gorm:"type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// OIDCLoginState 為導向外部身分提供者登入期間暫存的狀態，回呼時以 State 取回
+type OIDCLoginState struct {
+	State        string `json:"-"`
+	Provider     string `json:"provider"`
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"code_verifier"`
+}
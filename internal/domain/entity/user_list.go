@@ -0,0 +1,23 @@
+package entity
+
+import (
+	"time"
+
+	"server-template/internal/domain/entity/user"
+)
+
+// UserFilter 為列出使用者的篩選條件，零值的欄位不篩選
+type UserFilter struct {
+	Status      user.UserStatus
+	EmailPrefix string
+	// CreatedAfter 包含邊界，CreatedBefore 不包含邊界
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+}
+
+// UserPage 為一頁使用者，NextCursor 為空時表示已無下一頁
+type UserPage struct {
+	Users      []*User
+	NextCursor string
+	Total      int64
+}
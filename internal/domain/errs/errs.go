@@ -0,0 +1,121 @@
+// Package errs 定義領域錯誤目錄，讓 usecase 與 repository 回報的錯誤能被傳輸層轉換為對應的狀態碼
+package errs
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Kind 為領域錯誤的類型，其字串值會作為 gRPC ErrorInfo 的 reason 傳遞
+type Kind string
+
+const (
+	KindUnknown            Kind = "UNKNOWN"
+	KindNotFound           Kind = "NOT_FOUND"
+	KindAlreadyExists      Kind = "ALREADY_EXISTS"
+	KindInvalidCredentials Kind = "INVALID_CREDENTIALS"
+	KindValidationFailed   Kind = "VALIDATION_FAILED"
+	KindUnavailable        Kind = "UNAVAILABLE"
+	KindPermissionDenied   Kind = "PERMISSION_DENIED"
+	KindFailedPrecondition Kind = "FAILED_PRECONDITION"
+)
+
+// 供 errors.Is 比對錯誤類型使用
+var (
+	ErrNotFound           = &Error{Kind: KindNotFound}
+	ErrAlreadyExists      = &Error{Kind: KindAlreadyExists}
+	ErrInvalidCredentials = &Error{Kind: KindInvalidCredentials}
+	ErrValidationFailed   = &Error{Kind: KindValidationFailed}
+	ErrUnavailable        = &Error{Kind: KindUnavailable}
+	ErrPermissionDenied   = &Error{Kind: KindPermissionDenied}
+	ErrFailedPrecondition = &Error{Kind: KindFailedPrecondition}
+)
+
+// Violation 描述單一欄位驗證失敗的原因
+type Violation struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+// Error 為領域錯誤，Message 會回傳給呼叫端，cause 僅用於記錄
+type Error struct {
+	Kind       Kind
+	Message    string
+	Violations []Violation
+
+	cause error
+}
+
+// New 建立指定類型的錯誤
+func New(kind Kind, message string) *Error {
+	return &Error{Kind: kind, Message: message}
+}
+
+// Wrap 以指定類型包裝底層錯誤，底層錯誤仍可透過 errors.Is/As 取得
+func Wrap(err error, kind Kind, message string) *Error {
+	return &Error{Kind: kind, Message: message, cause: err}
+}
+
+// Validation 建立驗證失敗的錯誤，並附上各欄位的失敗原因
+func Validation(message string, violations ...Violation) *Error {
+	return &Error{Kind: KindValidationFailed, Message: message, Violations: violations}
+}
+
+// FieldError 以單一欄位的驗證錯誤建立驗證失敗的錯誤
+func FieldError(field string, err error) *Error {
+	return &Error{
+		Kind:       KindValidationFailed,
+		Message:    err.Error(),
+		Violations: []Violation{{Field: field, Description: err.Error()}},
+		cause:      err,
+	}
+}
+
+func (e *Error) Error() string {
+	message := e.PublicMessage()
+	if e.cause != nil && e.cause.Error() != message {
+		return message + ": " + e.cause.Error()
+	}
+
+	return message
+}
+
+// PublicMessage 回傳可提供給呼叫端的訊息，不包含底層錯誤
+func (e *Error) PublicMessage() string {
+	if e.Message != "" {
+		return e.Message
+	}
+
+	return strings.ToLower(strings.ReplaceAll(string(e.Kind), "_", " "))
+}
+
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// Is 讓 errors.Is(err, errs.ErrNotFound) 等以錯誤類型比對
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+
+	return ok && t.Message == "" && t.Kind == e.Kind
+}
+
+// As 取出錯誤鏈中最外層的領域錯誤
+func As(err error) (*Error, bool) {
+	var domainErr *Error
+	if errors.As(err, &domainErr) {
+		return domainErr, true
+	}
+
+	return nil, false
+}
+
+// KindOf 回傳錯誤鏈中最外層領域錯誤的類型，沒有領域錯誤時為 KindUnknown
+func KindOf(err error) Kind {
+	if domainErr, ok := As(err); ok {
+		return domainErr.Kind
+	}
+
+	return KindUnknown
+}
@@ -0,0 +1,26 @@
+package identity
+
+import (
+	"context"
+)
+
+// Identity 為外部身分提供者驗證後的使用者身分
+type Identity struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// Provider 為以授權碼 + PKCE 流程登入的外部 OIDC 身分提供者
+type Provider interface {
+	// AuthCodeURL 回傳將使用者導向提供者登入頁的網址
+	AuthCodeURL(state, nonce, codeVerifier string) string
+	// Exchange 以授權碼與 PKCE verifier 換取 ID token，驗證簽章與 nonce 後回傳使用者身分
+	Exchange(ctx context.Context, code, codeVerifier, nonce string) (*Identity, error)
+}
+
+// Providers 依設定中的名稱取得身分提供者
+type Providers interface {
+	Get(ctx context.Context, name string) (Provider, error)
+}
@@ -0,0 +1,32 @@
+package notification
+
+import (
+	"context"
+)
+
+type NotifierType string
+
+const (
+	NotifierLog NotifierType = "log"
+)
+
+func (n NotifierType) IsValid() bool {
+	switch n {
+	case NotifierLog:
+		return true
+	default:
+		return false
+	}
+}
+
+// Message 為寄送給使用者的通知
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Notifier 負責將通知送達使用者，例如 email 或簡訊
+type Notifier interface {
+	Send(ctx context.Context, msg *Message) error
+}
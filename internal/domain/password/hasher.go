@@ -0,0 +1,27 @@
+package password
+
+type Algorithm string
+
+const (
+	AlgorithmArgon2id Algorithm = "argon2id"
+	AlgorithmBcrypt   Algorithm = "bcrypt"
+)
+
+func (a Algorithm) IsValid() bool {
+	switch a {
+	case AlgorithmArgon2id, AlgorithmBcrypt:
+		return true
+	default:
+		return false
+	}
+}
+
+// Hasher 負責產生與驗證密碼雜湊值，雜湊值以 PHC 字串格式存放（bcrypt 沿用其 $2b$ 格式），
+// 因此可依雜湊值本身辨識演算法與參數
+type Hasher interface {
+	// Hash 以目前設定的演算法與參數雜湊明文密碼
+	Hash(password string) (string, error)
+	// Verify 比對明文密碼與雜湊值；needsRehash 表示雜湊值的演算法或參數與目前設定不同，
+	// 應於驗證成功後以 Hash 重新雜湊
+	Verify(password, encoded string) (match bool, needsRehash bool, err error)
+}
@@ -0,0 +1,12 @@
+package password
+
+import (
+	"context"
+)
+
+// Policy 檢查候選密碼是否符合密碼政策
+type Policy interface {
+	// Validate 檢查候選密碼，違反政策時回傳帶有全部違規項目的驗證錯誤，各項目的欄位皆為 field。
+	// userInputs 為不得出現在密碼中的使用者資訊，例如 email 或名稱。
+	Validate(ctx context.Context, field, candidate string, userInputs ...string) error
+}
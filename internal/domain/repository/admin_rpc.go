@@ -0,0 +1,7 @@
+package repository
+
+import (
+	"server-template/proto/pb/adminpb"
+)
+
+type AdminRPCRepository adminpb.AdminClient
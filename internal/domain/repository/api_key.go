@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"server-template/internal/domain/entity"
+)
+
+//go:generate go build -o generator ../../../cmd/generator/main.go
+//go:generate ./generator --source=./api_key.go --output=../../repository/api_key.gen.go --interface=APIKeyRepository --package=repository --tracer=api-key-repo-tracer --template=otel --module-name=server-template
+//go:generate rm generator
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *entity.APIKey) error
+	// FindByPrefix 以金鑰前綴查詢，包含已撤銷與已過期的金鑰
+	FindByPrefix(ctx context.Context, prefix string) (*entity.APIKey, error)
+	// ListByUserID 回傳使用者尚未撤銷的金鑰，依建立時間由新到舊排序
+	ListByUserID(ctx context.Context, userID string) ([]*entity.APIKey, error)
+	// Revoke 撤銷使用者的金鑰，回傳是否有金鑰被撤銷
+	Revoke(ctx context.Context, userID, id string) (bool, error)
+	// TouchLastUsed 更新金鑰最後使用時間
+	TouchLastUsed(ctx context.Context, id string, usedAt time.Time) error
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"server-template/internal/domain/entity"
+)
+
+//go:generate go build -o generator ../../../cmd/generator/main.go
+//go:generate ./generator --source=./audit_event.go --output=../../repository/audit_event.gen.go --interface=AuditEventRepository --package=repository --tracer=audit-event-repo-tracer --template=otel --module-name=server-template
+//go:generate rm generator
+type AuditEventRepository interface {
+	// Create 新增稽核事件，稽核事件寫入後不得修改或刪除，因此不提供對應的方法
+	Create(ctx context.Context, event *entity.AuditEvent) error
+	// List 回傳排序在 cursor 之後的至多 limit 個事件，依 (created_at, id) 由新到舊排序，cursor 為 nil 時從第一筆開始
+	List(ctx context.Context, filter entity.AuditEventFilter, cursor *entity.Cursor, limit int) ([]*entity.AuditEvent, error)
+	// ListByUserID 回傳由使用者執行或影響使用者的所有事件，依建立時間由新到舊排序
+	ListByUserID(ctx context.Context, userID string) ([]*entity.AuditEvent, error)
+}
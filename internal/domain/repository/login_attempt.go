@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate go build -o generator ../../../cmd/generator/main.go
+//go:generate ./generator --source=./login_attempt.go --output=../../repository/login_attempt.gen.go --interface=LoginAttemptRepository --package=repository --tracer=login-attempt-repo-tracer --template=otel --module-name=server-template
+//go:generate rm generator
+type LoginAttemptRepository interface {
+	// RecordFailure 累計 subject 的登入失敗次數並回傳目前次數，統計期間自第一次失敗起算
+	RecordFailure(ctx context.Context, subject string, window time.Duration) (int, error)
+	// Block 在 duration 內封鎖 subject 的登入嘗試
+	Block(ctx context.Context, subject string, duration time.Duration) error
+	// BlockedFor 回傳 subject 剩餘的封鎖時間，未封鎖時為 0
+	BlockedFor(ctx context.Context, subject string) (time.Duration, error)
+	// Reset 清除 subject 的失敗次數與封鎖
+	Reset(ctx context.Context, subject string) error
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"server-template/internal/domain/entity"
+)
+
+//go:generate go build -o generator ../../../cmd/generator/main.go
+//go:generate ./generator --source=./mfa.go --output=../../repository/mfa.gen.go --interface=MFARepository --package=repository --tracer=mfa-repo-tracer --template=otel --module-name=server-template
+//go:generate rm generator
+type MFARepository interface {
+	// Save 存儲尚未確認的 TOTP 設定，覆蓋使用者先前未完成的綁定
+	Save(ctx context.Context, setting *entity.MFASetting) error
+	// Enable 啟用使用者的 TOTP 設定，並以新的復原碼取代舊的復原碼
+	Enable(ctx context.Context, userID string, recoveryCodeHashes []string) error
+	// Delete 刪除使用者的 TOTP 設定與所有復原碼
+	Delete(ctx context.Context, userID string) error
+	// ConsumeRecoveryCode 將未使用的復原碼標記為已使用，回傳是否成功
+	ConsumeRecoveryCode(ctx context.Context, userID, codeHash string) (bool, error)
+}
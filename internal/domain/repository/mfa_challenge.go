@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"server-template/internal/domain/entity"
+)
+
+//go:generate go build -o generator ../../../cmd/generator/main.go
+//go:generate ./generator --source=./mfa_challenge.go --output=../../repository/mfa_challenge.gen.go --interface=MFAChallengeRepository --package=repository --tracer=mfa-challenge-repo-tracer --template=otel --module-name=server-template
+//go:generate rm generator
+type MFAChallengeRepository interface {
+	Save(ctx context.Context, challenge *entity.MFAChallenge) error
+	FindByID(ctx context.Context, id string) (*entity.MFAChallenge, error)
+	// RecordFailure 累計驗證失敗次數並回傳目前的次數
+	RecordFailure(ctx context.Context, id string) (int, error)
+	// Delete 刪除 challenge，回傳是否由此次呼叫刪除，用於確保 challenge 只能使用一次
+	Delete(ctx context.Context, id string) (bool, error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"server-template/internal/domain/entity"
+)
+
+//go:generate go build -o generator ../../../cmd/generator/main.go
+//go:generate ./generator --source=./oauth_client.go --output=../../repository/oauth_client.gen.go --interface=OAuthClientRepository --package=repository --tracer=oauth-client-repo-tracer --template=otel --module-name=server-template
+//go:generate rm generator
+type OAuthClientRepository interface {
+	Create(ctx context.Context, client *entity.OAuthClient) error
+	// FindByID 以 client_id 查詢，包含已撤銷的用戶端
+	FindByID(ctx context.Context, id string) (*entity.OAuthClient, error)
+	// Revoke 撤銷用戶端，回傳是否有用戶端被撤銷
+	Revoke(ctx context.Context, id string) (bool, error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"server-template/internal/domain/entity"
+)
+
+//go:generate go build -o generator ../../../cmd/generator/main.go
+//go:generate ./generator --source=./oidc_state.go --output=../../repository/oidc_state.gen.go --interface=OIDCStateRepository --package=repository --tracer=oidc-state-repo-tracer --template=otel --module-name=server-template
+//go:generate rm generator
+type OIDCStateRepository interface {
+	// Save 存儲導向身分提供者登入期間的狀態
+	Save(ctx context.Context, state *entity.OIDCLoginState, ttl time.Duration) error
+	// Consume 取出並刪除狀態，確保每個 state 只能完成一次回呼
+	Consume(ctx context.Context, state string) (*entity.OIDCLoginState, error)
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"server-template/internal/domain/entity"
+)
+
+//go:generate go build -o generator ../../../cmd/generator/main.go
+//go:generate ./generator --source=./one_time_token.go --output=../../repository/one_time_token.gen.go --interface=OneTimeTokenRepository --package=repository --tracer=one-time-token-repo-tracer --template=otel --module-name=server-template
+//go:generate rm generator
+type OneTimeTokenRepository interface {
+	// Save 存儲 token 雜湊，並使該使用者同一用途下先前的 token 失效
+	Save(ctx context.Context, purpose entity.OneTimeTokenPurpose, tokenHash, userID string, ttl time.Duration) error
+	// Find 回傳 token 所屬的使用者 ID，不會使 token 失效
+	Find(ctx context.Context, purpose entity.OneTimeTokenPurpose, tokenHash string) (string, error)
+	// Consume 取出並刪除 token，回傳其所屬的使用者 ID
+	Consume(ctx context.Context, purpose entity.OneTimeTokenPurpose, tokenHash string) (string, error)
+	// Revoke 使該使用者在此用途下尚未使用的 token 失效
+	Revoke(ctx context.Context, purpose entity.OneTimeTokenPurpose, userID string) error
+}
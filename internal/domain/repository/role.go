@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"server-template/internal/domain/entity"
+)
+
+//go:generate go build -o generator ../../../cmd/generator/main.go
+//go:generate ./generator --source=./role.go --output=../../repository/role.gen.go --interface=RoleRepository --package=repository --tracer=role-repo-tracer --template=otel --module-name=server-template
+//go:generate rm generator
+type RoleRepository interface {
+	// FindByName 以名稱查詢角色
+	FindByName(ctx context.Context, name string) (*entity.Role, error)
+	// FindByUserID 回傳使用者擁有的角色，並一併載入各角色的權限
+	FindByUserID(ctx context.Context, userID string) ([]*entity.Role, error)
+	// AssignToUser 將角色授予使用者，已擁有該角色時不做任何事
+	AssignToUser(ctx context.Context, userID, roleID string) error
+	// RemoveFromUser 移除使用者的角色，回傳使用者原本是否擁有該角色
+	RemoveFromUser(ctx context.Context, userID, roleID string) (bool, error)
+}
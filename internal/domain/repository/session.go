@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"server-template/internal/domain/entity"
+)
+
+//go:generate go build -o generator ../../../cmd/generator/main.go
+//go:generate ./generator --source=./session.go --output=../../repository/session.gen.go --interface=SessionRepository --package=repository --tracer=session-repo-tracer --template=otel --module-name=server-template
+//go:generate rm generator
+type SessionRepository interface {
+	Save(ctx context.Context, session *entity.Session) error
+	FindByID(ctx context.Context, id string) (*entity.Session, error)
+	ListByUserID(ctx context.Context, userID string) ([]*entity.Session, error)
+	Delete(ctx context.Context, userID, id string) error
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"server-template/internal/domain/entity"
+	"server-template/internal/domain/entity/user"
 )
 
 //go:generate go build -o generator ../../../cmd/generator/main.go
@@ -13,4 +14,13 @@ type UserRepository interface {
 	Create(ctx context.Context, user *entity.User) error
 	FindByEmail(ctx context.Context, email string) (*entity.User, error)
 	FindByID(ctx context.Context, id string) (*entity.User, error)
+	// List 依 (created_at, id) 由新到舊列出符合條件的使用者，cursor 為 nil 時由第一筆開始
+	List(ctx context.Context, filter entity.UserFilter, cursor *entity.Cursor, limit int) ([]*entity.User, error)
+	Count(ctx context.Context, filter entity.UserFilter) (int64, error)
+	// Update 更新使用者的名稱、email 與待驗證的 email
+	Update(ctx context.Context, user *entity.User) error
+	UpdatePassword(ctx context.Context, id, hashedPassword string) error
+	UpdateStatus(ctx context.Context, id string, status user.UserStatus) error
+	// Erase 以 user 的內容覆寫使用者的個人資料與狀態，並刪除其 MFA 設定、外部身分、角色與 API key
+	Erase(ctx context.Context, user *entity.User) error
 }
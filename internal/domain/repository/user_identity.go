@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"server-template/internal/domain/entity"
+)
+
+//go:generate go build -o generator ../../../cmd/generator/main.go
+//go:generate ./generator --source=./user_identity.go --output=../../repository/user_identity.gen.go --interface=UserIdentityRepository --package=repository --tracer=user-identity-repo-tracer --template=otel --module-name=server-template
+//go:generate rm generator
+type UserIdentityRepository interface {
+	Create(ctx context.Context, identity *entity.UserIdentity) error
+	// FindByProviderSubject 以提供者名稱與 subject 查詢已連結的外部身分
+	FindByProviderSubject(ctx context.Context, provider, subject string) (*entity.UserIdentity, error)
+	// ListByUserID 回傳連結至使用者的所有外部身分
+	ListByUserID(ctx context.Context, userID string) ([]*entity.UserIdentity, error)
+	// TouchLastLogin 更新外部身分最後登入的時間
+	TouchLastLogin(ctx context.Context, id string, loginAt time.Time) error
+}
@@ -0,0 +1,20 @@
+package usecase
+
+import (
+	"context"
+
+	"server-template/internal/domain/entity"
+)
+
+//go:generate go build -o generator ../../../cmd/generator/main.go
+//go:generate ./generator --source=./admin.go --output=../../usecase/admin.gen.go --interface=AdminUseCase --package=usecase --tracer=admin-usecase-tracer --template=otel --module-name=server-template
+//go:generate rm generator
+type AdminUseCase interface {
+	ListUsers(ctx context.Context, filter entity.UserFilter, pageToken string, pageSize int) (*entity.UserPage, error)
+	GetUser(ctx context.Context, userID string) (*entity.User, error)
+	SuspendUser(ctx context.Context, userID string) (*entity.User, error)
+	ReactivateUser(ctx context.Context, userID string) (*entity.User, error)
+	ExportUser(ctx context.Context, userID string) (*entity.UserExport, error)
+	EraseUser(ctx context.Context, userID string) (*entity.User, error)
+	ListAuditEvents(ctx context.Context, filter entity.AuditEventFilter, pageToken string, pageSize int) (*entity.AuditEventPage, error)
+}
@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	"context"
+
+	"server-template/internal/domain/entity"
+)
+
+//go:generate go build -o generator ../../../cmd/generator/main.go
+//go:generate ./generator --source=./admin_http.go --output=../../usecase/admin_http.gen.go --interface=AdminHTTPUseCase --package=usecase --tracer=admin-http-usecase-tracer --template=otel --module-name=server-template
+//go:generate rm generator
+type AdminHTTPUseCase interface {
+	ListUsers(ctx context.Context, authorization string, filter entity.UserFilter, pageToken string, pageSize int) (*entity.UserPage, error)
+	GetUser(ctx context.Context, authorization, userID string) (*entity.User, error)
+	SuspendUser(ctx context.Context, authorization, userID string) (*entity.User, int, error)
+	ReactivateUser(ctx context.Context, authorization, userID string) (*entity.User, error)
+	LogoutUser(ctx context.Context, authorization, userID string) (int, error)
+	ExportUser(ctx context.Context, authorization, userID string) ([]byte, error)
+	EraseUser(ctx context.Context, authorization, userID string) (*entity.User, int, error)
+	ListAuditEvents(ctx context.Context, authorization string, filter entity.AuditEventFilter, pageToken string, pageSize int) (*entity.AuditEventPage, error)
+}
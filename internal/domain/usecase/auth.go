@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"server-template/internal/domain/entity"
 )
@@ -10,7 +11,31 @@ import (
 //go:generate ./generator --source=./auth.go --output=../../usecase/auth.gen.go --interface=AuthUseCase --package=usecase --tracer=auth-usecase-tracer --template=otel --module-name=server-template
 //go:generate rm generator
 type AuthUseCase interface {
-	Register(ctx context.Context, email string, hashedPassword string) (*entity.User, error)
-	Login(ctx context.Context, email string, hashedPassword string) (*entity.User, error)
+	Register(ctx context.Context, email string, hashedPassword string, name string) (*entity.User, error)
+	Login(ctx context.Context, email string, hashedPassword string, client entity.ClientInfo) (*entity.User, error)
 	GetUserByID(ctx context.Context, userID string) (*entity.User, error)
+	RequestPasswordReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token string, newPassword string) (*entity.User, error)
+	VerifyEmail(ctx context.Context, token string) (*entity.User, error)
+	ResendVerification(ctx context.Context, email string) error
+	EnrollMFA(ctx context.Context, userID string) (*entity.MFAEnrollment, error)
+	ConfirmMFA(ctx context.Context, userID string, code string) ([]string, error)
+	DisableMFA(ctx context.Context, userID string, code string) error
+	CreateMFAChallenge(ctx context.Context, userID string) (string, error)
+	VerifyMFA(ctx context.Context, challengeID string, code string) (*entity.User, error)
+	UnlockAccount(ctx context.Context, email string) error
+	GetUserAccess(ctx context.Context, userID string) (*entity.Access, error)
+	GrantRole(ctx context.Context, userID string, roleName string) error
+	RevokeRole(ctx context.Context, userID string, roleName string) error
+	CreateAPIKey(ctx context.Context, userID string, name string, scopes []string, expiresAt *time.Time, callerPermissions []string) (*entity.APIKey, string, error)
+	ListAPIKeys(ctx context.Context, userID string) ([]*entity.APIKey, error)
+	RevokeAPIKey(ctx context.Context, userID string, keyID string) error
+	AuthenticateAPIKey(ctx context.Context, rawKey string) (*entity.APIKeyPrincipal, error)
+	CreateOAuthClient(ctx context.Context, name string, scopes []string, grantTypes []string, callerPermissions []string) (*entity.OAuthClient, string, error)
+	RevokeOAuthClient(ctx context.Context, clientID string) error
+	AuthenticateOAuthClient(ctx context.Context, credentials entity.ClientCredentials) (*entity.OAuthClient, error)
+	StartOIDCLogin(ctx context.Context, providerName string) (string, string, error)
+	CompleteOIDCLogin(ctx context.Context, providerName string, state string, code string) (*entity.User, error)
+	UpdateProfile(ctx context.Context, userID string, name *string, email *string) (*entity.User, error)
+	ChangePassword(ctx context.Context, userID string, currentPassword string, newPassword string) error
 }
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"server-template/internal/domain/entity"
 	"server-template/proto/pb/authpb"
@@ -11,8 +12,36 @@ import (
 //go:generate ./generator --source=./auth_http.go --output=../../usecase/auth_http.gen.go --interface=AuthHTTPUseCase --package=usecase --tracer=auth-http-usecase-tracer --template=otel --module-name=server-template
 //go:generate rm generator
 type AuthHTTPUseCase interface {
-	Register(ctx context.Context, email, password string) (string, *entity.User, error)
-	Login(ctx context.Context, email, password string) (string, *entity.User, error)
-	Logout(ctx context.Context, token string) error
+	Register(ctx context.Context, email, password, name string) (*entity.User, error)
+	Login(ctx context.Context, email, password string, client entity.ClientInfo) (*entity.LoginResult, error)
+	StartOIDCLogin(ctx context.Context, provider string) (string, string, error)
+	CompleteOIDCLogin(ctx context.Context, provider, state, code string, client entity.ClientInfo) (*entity.LoginResult, error)
+	Logout(ctx context.Context, token, refreshToken string) error
 	ValidateToken(ctx context.Context, token string) (*authpb.ValidateTokenResponse, error)
+	RefreshToken(ctx context.Context, refreshToken string) (*entity.TokenPair, error)
+	ListSessions(ctx context.Context, authorization, userID string) ([]*entity.Session, error)
+	RevokeSession(ctx context.Context, authorization, userID, sessionID string) error
+	RevokeAllSessions(ctx context.Context, authorization, userID, exceptSessionID string) (int, error)
+	RequestPasswordReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token, newPassword string) error
+	GetProfile(ctx context.Context, authorization, userID string) (*entity.User, error)
+	UpdateProfile(ctx context.Context, authorization, userID string, name, email *string) (*entity.User, error)
+	ChangePassword(ctx context.Context, authorization, userID, currentPassword, newPassword string) (int, error)
+	VerifyEmail(ctx context.Context, token string) (*entity.User, error)
+	ResendVerification(ctx context.Context, email string) error
+	VerifyMFA(ctx context.Context, challengeID, code string, client entity.ClientInfo) (*entity.TokenPair, *entity.User, error)
+	EnrollMFA(ctx context.Context, authorization, userID string) (*entity.MFAEnrollment, error)
+	ConfirmMFA(ctx context.Context, authorization, userID, code string) ([]string, error)
+	DisableMFA(ctx context.Context, authorization, userID, code string) error
+	GrantRole(ctx context.Context, authorization, userID, role string) error
+	RevokeRole(ctx context.Context, authorization, userID, role string) error
+	CreateAPIKey(ctx context.Context, authorization, userID, name string, scopes []string, expiresAt *time.Time) (*entity.APIKey, string, error)
+	ListAPIKeys(ctx context.Context, authorization, userID string) ([]*entity.APIKey, error)
+	RevokeAPIKey(ctx context.Context, authorization, userID, keyID string) error
+	ValidateAPIKey(ctx context.Context, key string) (*authpb.ValidateAPIKeyResponse, error)
+	CreateOAuthClient(ctx context.Context, authorization, name string, scopes, grantTypes []string) (*entity.OAuthClient, string, error)
+	RevokeOAuthClient(ctx context.Context, authorization, clientID string) error
+	IssueOAuth2Token(ctx context.Context, req entity.OAuth2TokenRequest) (*entity.OAuth2Token, error)
+	IntrospectToken(ctx context.Context, client entity.ClientCredentials, token, tokenTypeHint string) (*entity.TokenIntrospection, error)
+	RevokeToken(ctx context.Context, client entity.ClientCredentials, token, tokenTypeHint string) error
 }
@@ -0,0 +1,24 @@
+package audit
+
+import (
+	"context"
+	"log/slog"
+
+	"server-template/internal/domain/audit"
+	"server-template/internal/domain/entity"
+)
+
+// logSink 將稽核事件寫入日誌，供日誌收集系統轉送
+type logSink struct {
+	logger *slog.Logger
+}
+
+func newLogSink(logger *slog.Logger) audit.Sink {
+	return &logSink{logger: logger}
+}
+
+func (s *logSink) Write(ctx context.Context, event *entity.AuditEvent) error {
+	s.logger.InfoContext(ctx, "Audit event", eventAttrs(event)...)
+
+	return nil
+}
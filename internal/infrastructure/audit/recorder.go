@@ -0,0 +1,109 @@
+package audit
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"server-template/config"
+	"server-template/internal/domain/audit"
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/repository"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/fx"
+)
+
+// Params 定義 recorder 所需的參數
+type Params struct {
+	fx.In
+
+	Config *config.Config
+	Logger *slog.Logger
+	Events repository.AuditEventRepository
+}
+
+type recorder struct {
+	logger *slog.Logger
+	events repository.AuditEventRepository
+	sinks  []audit.Sink
+}
+
+// New 創建以 audit_events 表為主要儲存的 recorder，並依設定建立次要 Sink
+func New(params Params) (audit.Recorder, error) {
+	sinks := make([]audit.Sink, 0, len(params.Config.Audit.Sinks))
+	for _, name := range params.Config.Audit.Sinks {
+		sinkType := audit.SinkType(name)
+		if !sinkType.IsValid() {
+			return nil, errors.Errorf("unsupported audit sink type: %s", sinkType)
+		}
+
+		switch sinkType {
+		case audit.SinkLog:
+			sinks = append(sinks, newLogSink(params.Logger))
+		default:
+			return nil, errors.Errorf("unsupported audit sink type: %s", sinkType)
+		}
+	}
+
+	return &recorder{
+		logger: params.Logger,
+		events: params.Events,
+		sinks:  sinks,
+	}, nil
+}
+
+func (r *recorder) Record(ctx context.Context, event *entity.AuditEvent) {
+	// 事件 ID 與時間由 recorder 決定，使各 Sink 收到的事件一致
+	event.ID = uuid.New().String()
+	event.CreatedAt = time.Now().UTC()
+
+	if event.ActorID == "" {
+		event.ActorID = audit.ActorFromContext(ctx)
+	}
+	client := audit.ClientFromContext(ctx)
+	if event.IPAddress == "" {
+		event.IPAddress = client.IPAddress
+	}
+	if event.UserAgent == "" {
+		event.UserAgent = client.UserAgent
+	}
+	if spanContext := trace.SpanContextFromContext(ctx); event.TraceID == "" && spanContext.HasTraceID() {
+		event.TraceID = spanContext.TraceID().String()
+	}
+
+	// 請求取消後仍需寫入稽核事件
+	ctx = context.WithoutCancel(ctx)
+
+	if err := r.events.Create(ctx, event); err != nil {
+		// 寫入失敗時將完整事件記錄於日誌，避免事件遺失
+		r.logger.ErrorContext(ctx, "Failed to record audit event", append(eventAttrs(event), slog.Any("error", err))...)
+	}
+
+	for _, sink := range r.sinks {
+		if err := sink.Write(ctx, event); err != nil {
+			r.logger.WarnContext(ctx, "Failed to write audit event to sink",
+				slog.String("event_id", event.ID),
+				slog.Any("error", err),
+			)
+		}
+	}
+}
+
+// eventAttrs 將事件轉換為日誌欄位
+func eventAttrs(event *entity.AuditEvent) []any {
+	return []any{
+		slog.String("event_id", event.ID),
+		slog.String("type", string(event.Type)),
+		slog.String("actor_id", event.ActorID),
+		slog.String("subject_id", event.SubjectID),
+		slog.String("ip_address", event.IPAddress),
+		slog.String("user_agent", event.UserAgent),
+		slog.String("outcome", string(event.Outcome)),
+		slog.String("reason", event.Reason),
+		slog.String("trace_id", event.TraceID),
+		slog.Time("created_at", event.CreatedAt),
+	}
+}
@@ -0,0 +1,61 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/fx"
+	"gorm.io/gorm"
+)
+
+// Dependency 為服務依賴的外部資源
+type Dependency string
+
+const (
+	Postgres Dependency = "postgres"
+	Redis    Dependency = "redis"
+)
+
+// Checker 以 ping 檢查外部資源是否可用
+type Checker struct {
+	postgres *sql.DB
+	redis    *redis.ClusterClient
+}
+
+type Params struct {
+	fx.In
+
+	Postgres *gorm.DB `name:"default_postgres" optional:"true"`
+	Redis    *redis.ClusterClient
+}
+
+func New(params Params) (*Checker, error) {
+	checker := &Checker{redis: params.Redis}
+
+	if params.Postgres != nil {
+		sqlDB, err := params.Postgres.DB()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get PostgreSQL sql.DB")
+		}
+		checker.postgres = sqlDB
+	}
+
+	return checker, nil
+}
+
+// Check 檢查所有外部資源，回傳各資源的檢查結果，可用時為 nil；未設定的資源視為不可用
+func (c *Checker) Check(ctx context.Context) map[Dependency]error {
+	results := make(map[Dependency]error, 2)
+
+	if c.postgres == nil {
+		results[Postgres] = errors.New("PostgreSQL is not configured")
+	} else {
+		results[Postgres] = errors.Wrap(c.postgres.PingContext(ctx), "failed to ping PostgreSQL")
+	}
+
+	results[Redis] = errors.Wrap(c.redis.Ping(ctx).Err(), "failed to ping Redis")
+
+	return results
+}
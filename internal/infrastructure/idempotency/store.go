@@ -0,0 +1,152 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"server-template/config"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	keyPrefix = "idempotency"
+
+	defaultTTL         = 24 * time.Hour
+	defaultLockTimeout = 10 * time.Second
+)
+
+// Record 為已完成請求的回應，Fingerprint 用於確認重送的請求內容與原請求相同
+type Record struct {
+	Fingerprint string      `json:"fingerprint"`
+	StatusCode  int         `json:"status_code"`
+	Header      http.Header `json:"header"`
+	Body        []byte      `json:"body"`
+}
+
+// unlockScript 僅在鎖仍由自己持有時釋放，避免鎖逾時後誤刪其他請求取得的鎖
+var unlockScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+// extendScript 僅在鎖仍由自己持有時延長其有效期
+var extendScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// Store 以 Redis 保存帶有 Idempotency-Key 的請求回應，並以鎖讓同一 key 的請求依序處理
+type Store struct {
+	enable      bool
+	redis       *redis.ClusterClient
+	ttl         time.Duration
+	lockTimeout time.Duration
+}
+
+func New(cfg *config.Config, client *redis.ClusterClient) *Store {
+	store := &Store{
+		enable:      cfg.Idempotency.Enable,
+		redis:       client,
+		ttl:         cfg.Idempotency.TTL,
+		lockTimeout: cfg.Idempotency.LockTimeout,
+	}
+	if store.ttl <= 0 {
+		store.ttl = defaultTTL
+	}
+	if store.lockTimeout <= 0 {
+		store.lockTimeout = defaultLockTimeout
+	}
+
+	return store
+}
+
+// Enabled 表示是否啟用 Idempotency-Key 支援
+func (s *Store) Enabled() bool {
+	return s.enable
+}
+
+// LockTimeout 回傳重複請求等待原請求完成的最長時間，也是鎖未延長時的有效期
+func (s *Store) LockTimeout() time.Duration {
+	return s.lockTimeout
+}
+
+// Key 回傳呼叫端的 Idempotency-Key 在 Redis 中的識別值，scope 為呼叫端身分，避免不同使用者的 key 互相衝突
+func Key(scope, idempotencyKey string) string {
+	sum := sha256.Sum256([]byte(scope + "\x00" + idempotencyKey))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// Get 取得已保存的回應，不存在時回傳 nil
+func (s *Store) Get(ctx context.Context, key string) (*Record, error) {
+	payload, err := s.redis.Get(ctx, recordKey(key)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get idempotency record")
+	}
+
+	record := new(Record)
+	if err := json.Unmarshal(payload, record); err != nil {
+		return nil, errors.Wrap(err, "failed to decode idempotency record")
+	}
+
+	return record, nil
+}
+
+// Save 保存回應，保存期間內以相同 key 重送的請求會重播此回應
+func (s *Store) Save(ctx context.Context, key string, record *Record) error {
+	payload, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode idempotency record")
+	}
+
+	return errors.Wrap(s.redis.Set(ctx, recordKey(key), payload, s.ttl).Err(), "failed to save idempotency record")
+}
+
+// Lock 嘗試取得 key 的處理權，已被其他請求持有時 ok 為 false；token 用於釋放鎖
+func (s *Store) Lock(ctx context.Context, key string) (token string, ok bool, err error) {
+	token = rand.Text()
+
+	ok, err = s.redis.SetNX(ctx, lockKey(key), token, s.lockTimeout).Result()
+	if err != nil {
+		return "", false, errors.Wrap(err, "failed to acquire idempotency lock")
+	}
+
+	return token, ok, nil
+}
+
+// Extend 將以 Lock 取得的鎖的有效期重設為 LockTimeout，鎖已不由自己持有時 ok 為 false
+func (s *Store) Extend(ctx context.Context, key, token string) (ok bool, err error) {
+	extended, err := extendScript.Run(ctx, s.redis, []string{lockKey(key)}, token, s.lockTimeout.Milliseconds()).Int()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to extend idempotency lock")
+	}
+
+	return extended == 1, nil
+}
+
+// Unlock 釋放以 Lock 取得的鎖
+func (s *Store) Unlock(ctx context.Context, key, token string) error {
+	return errors.Wrap(unlockScript.Run(ctx, s.redis, []string{lockKey(key)}, token).Err(), "failed to release idempotency lock")
+}
+
+func recordKey(key string) string {
+	return keyPrefix + ":" + key
+}
+
+func lockKey(key string) string {
+	return keyPrefix + ":lock:" + key
+}
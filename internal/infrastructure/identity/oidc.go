@@ -0,0 +1,129 @@
+package identity
+
+import (
+	"context"
+	"sync"
+
+	"server-template/config"
+	"server-template/internal/domain/errs"
+	"server-template/internal/domain/identity"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/pkg/errors"
+	"go.uber.org/fx"
+	"golang.org/x/oauth2"
+)
+
+// Params 定義身分提供者所需的參數
+type Params struct {
+	fx.In
+
+	Config *config.Config
+}
+
+// providers 於第一次使用時才讀取各提供者的 discovery 文件，避免提供者暫時無法連線時影響服務啟動
+type providers struct {
+	configs map[string]config.OIDCProviderConfig
+
+	mu     sync.Mutex
+	loaded map[string]identity.Provider
+}
+
+// New 依設定創建 OIDC 身分提供者
+func New(params Params) identity.Providers {
+	return &providers{
+		configs: params.Config.Auth.OIDC.Providers,
+		loaded:  make(map[string]identity.Provider),
+	}
+}
+
+func (p *providers) Get(ctx context.Context, name string) (identity.Provider, error) {
+	cfg, ok := p.configs[name]
+	if !ok {
+		return nil, errs.New(errs.KindNotFound, "identity provider "+name+" is not configured")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if provider, ok := p.loaded[name]; ok {
+		return provider, nil
+	}
+
+	provider, err := newOIDCProvider(ctx, cfg)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.KindUnavailable, "identity provider "+name+" is unavailable")
+	}
+	p.loaded[name] = provider
+
+	return provider, nil
+}
+
+type oidcProvider struct {
+	oauth2   oauth2.Config
+	verifier *oidc.IDTokenVerifier
+}
+
+func newOIDCProvider(ctx context.Context, cfg config.OIDCProviderConfig) (*oidcProvider, error) {
+	// discovery 取得的 provider 會在之後驗證 token 時重新讀取 JWKS，不可綁定於單一請求的 context
+	provider, err := oidc.NewProvider(context.WithoutCancel(ctx), cfg.Issuer)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to discover OIDC provider")
+	}
+
+	scopes := cfg.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{oidc.ScopeOpenID, "email", "profile"}
+	}
+
+	return &oidcProvider{
+		oauth2: oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			Endpoint:     provider.Endpoint(),
+			RedirectURL:  cfg.RedirectURL,
+			Scopes:       scopes,
+		},
+		verifier: provider.Verifier(&oidc.Config{ClientID: cfg.ClientID}),
+	}, nil
+}
+
+func (p *oidcProvider) AuthCodeURL(state, nonce, codeVerifier string) string {
+	return p.oauth2.AuthCodeURL(state, oidc.Nonce(nonce), oauth2.S256ChallengeOption(codeVerifier))
+}
+
+func (p *oidcProvider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (*identity.Identity, error) {
+	token, err := p.oauth2.Exchange(ctx, code, oauth2.VerifierOption(codeVerifier))
+	if err != nil {
+		return nil, errs.Wrap(err, errs.KindInvalidCredentials, "failed to exchange authorization code")
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return nil, errs.New(errs.KindInvalidCredentials, "identity provider did not return an ID token")
+	}
+
+	idToken, err := p.verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.KindInvalidCredentials, "invalid ID token")
+	}
+	if idToken.Nonce != nonce {
+		return nil, errs.New(errs.KindInvalidCredentials, "invalid ID token nonce")
+	}
+
+	var claims struct {
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+		Name          string `json:"name"`
+	}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, errors.Wrap(err, "failed to parse ID token claims")
+	}
+
+	return &identity.Identity{
+		Subject:       idToken.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+		Name:          claims.Name,
+	}, nil
+}
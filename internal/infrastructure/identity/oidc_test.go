@@ -0,0 +1,80 @@
+package identity
+
+import (
+	"context"
+	"testing"
+
+	"server-template/config"
+	"server-template/internal/domain/errs"
+	"server-template/internal/infrastructure/identity/identitytest"
+
+	"golang.org/x/oauth2"
+)
+
+func newTestProvider(t *testing.T, issuer *identitytest.Issuer) *oidcProvider {
+	t.Helper()
+
+	provider, err := newOIDCProvider(context.Background(), config.OIDCProviderConfig{
+		Issuer:       issuer.URL(),
+		ClientID:     identitytest.ClientID,
+		ClientSecret: identitytest.ClientSecret,
+		RedirectURL:  identitytest.RedirectURL,
+	})
+	if err != nil {
+		t.Fatalf("newOIDCProvider: %v", err)
+	}
+
+	return provider
+}
+
+func TestExchange(t *testing.T) {
+	issuer := identitytest.NewIssuer(t)
+	provider := newTestProvider(t, issuer)
+
+	verifier := oauth2.GenerateVerifier()
+	_, code := issuer.Authorize(t, provider.AuthCodeURL("state", "nonce", verifier), identitytest.User{
+		Subject:       "subject-1",
+		Email:         "alice@example.com",
+		EmailVerified: true,
+		Name:          "Alice",
+	})
+
+	ident, err := provider.Exchange(context.Background(), code, verifier, "nonce")
+	if err != nil {
+		t.Fatalf("Exchange: %v", err)
+	}
+
+	if ident.Subject != "subject-1" || ident.Email != "alice@example.com" || !ident.EmailVerified || ident.Name != "Alice" {
+		t.Errorf("Exchange returned %+v", ident)
+	}
+}
+
+func TestExchangeRejectsNonceMismatch(t *testing.T) {
+	issuer := identitytest.NewIssuer(t)
+	provider := newTestProvider(t, issuer)
+
+	verifier := oauth2.GenerateVerifier()
+	_, code := issuer.Authorize(t, provider.AuthCodeURL("state", "nonce", verifier), identitytest.User{
+		Subject: "subject-1",
+		Nonce:   "replayed-nonce",
+	})
+
+	_, err := provider.Exchange(context.Background(), code, verifier, "nonce")
+	if errs.KindOf(err) != errs.KindInvalidCredentials {
+		t.Fatalf("Exchange error = %v, want invalid credentials", err)
+	}
+}
+
+func TestExchangeRejectsWrongCodeVerifier(t *testing.T) {
+	issuer := identitytest.NewIssuer(t)
+	provider := newTestProvider(t, issuer)
+
+	_, code := issuer.Authorize(t, provider.AuthCodeURL("state", "nonce", oauth2.GenerateVerifier()), identitytest.User{
+		Subject: "subject-1",
+	})
+
+	_, err := provider.Exchange(context.Background(), code, oauth2.GenerateVerifier(), "nonce")
+	if errs.KindOf(err) != errs.KindInvalidCredentials {
+		t.Fatalf("Exchange error = %v, want invalid credentials", err)
+	}
+}
@@ -0,0 +1,317 @@
+package jwtkey
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"log/slog"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+
+	"server-template/config"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+	"go.uber.org/fx"
+)
+
+// secretKID 為以 JWTSecret 簽發的 HS256 token 的 kid，用於與未帶 kid 的舊 token 區分
+const secretKID = "hs256"
+
+// signingKey 為一把已載入的簽章金鑰
+type signingKey struct {
+	kid        string
+	method     jwt.SigningMethod
+	private    crypto.Signer
+	activeFrom time.Time
+}
+
+// JSONWebKey 為 RFC 7517 定義的公開金鑰格式
+type JSONWebKey struct {
+	KID string `json:"kid"`
+	Kty string `json:"kty"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JSONWebKeySet 為 /.well-known/jwks.json 回傳的內容
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// KeySet 管理 JWT 的簽章與驗證金鑰。
+// 設定 SigningKeys 時以最新一把已生效的金鑰簽章，所有已載入的金鑰皆可用於驗證；
+// 未設定時退回使用 JWTSecret 以 HS256 簽章。
+type KeySet struct {
+	cfg    *config.Config
+	logger *slog.Logger
+
+	mu   sync.RWMutex
+	keys []*signingKey
+}
+
+type Params struct {
+	fx.In
+	fx.Lifecycle
+
+	Config *config.Config
+	Logger *slog.Logger
+}
+
+// New 載入設定中的簽章金鑰，並在設定 KeyRotationInterval 時定期從磁碟重新載入
+func New(params Params) (*KeySet, error) {
+	keySet := &KeySet{
+		cfg:    params.Config,
+		logger: params.Logger,
+	}
+
+	if err := keySet.reload(); err != nil {
+		return nil, err
+	}
+
+	interval := params.Config.Auth.KeyRotationInterval
+	if len(params.Config.Auth.SigningKeys) == 0 || interval <= 0 {
+		return keySet, nil
+	}
+
+	done := make(chan struct{})
+	params.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go keySet.rotate(interval, done)
+
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			close(done)
+
+			return nil
+		},
+	})
+
+	return keySet, nil
+}
+
+// rotate 定期重新讀取設定中各金鑰路徑的 PEM 檔案，讓替換後的檔案無需重啟即可生效；
+// SigningKeys 設定本身不會重新載入，新增的金鑰需重啟後才會使用
+func (k *KeySet) rotate(interval time.Duration, done <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			if err := k.reload(); err != nil {
+				k.logger.Error("Failed to reload signing keys", slog.Any("error", err))
+
+				continue
+			}
+
+			kid, _, _ := k.SigningKey()
+			k.logger.Info("Signing keys reloaded", slog.String("active_kid", kid))
+		}
+	}
+}
+
+func (k *KeySet) reload() error {
+	keys := make([]*signingKey, 0, len(k.cfg.Auth.SigningKeys))
+	for _, keyConfig := range k.cfg.Auth.SigningKeys {
+		key, err := loadSigningKey(keyConfig)
+		if err != nil {
+			return errors.Wrapf(err, "failed to load signing key: %s", keyConfig.KID)
+		}
+
+		keys = append(keys, key)
+	}
+
+	k.mu.Lock()
+	k.keys = keys
+	k.mu.Unlock()
+
+	return nil
+}
+
+// SigningKey 回傳目前用於簽章的 kid、簽章演算法與私鑰
+func (k *KeySet) SigningKey() (string, jwt.SigningMethod, any) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	if len(k.keys) == 0 {
+		return secretKID, jwt.SigningMethodHS256, []byte(k.cfg.Auth.JWTSecret)
+	}
+
+	// 金鑰由舊到新排列，選擇最新一把已生效的金鑰；尚未生效的金鑰只用於驗證，
+	// 讓下游在開始簽章前就能從 JWKS 取得新的公開金鑰。全部尚未生效時使用第一把。
+	key := k.keys[0]
+	now := time.Now()
+	for _, candidate := range k.keys {
+		if !candidate.activeFrom.After(now) {
+			key = candidate
+		}
+	}
+
+	return key.kid, key.method, key.private
+}
+
+// Sign 以目前的簽章金鑰簽發帶有 kid 標頭的 token
+func (k *KeySet) Sign(claims jwt.Claims) (string, error) {
+	kid, method, key := k.SigningKey()
+
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = kid
+
+	tokenString, err := token.SignedString(key)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to sign token")
+	}
+
+	return tokenString, nil
+}
+
+// Keyfunc 依 token 標頭中的 kid 選擇驗證金鑰，供 jwt.ParseWithClaims 使用
+func (k *KeySet) Keyfunc(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	if kid == "" || kid == secretKID {
+		return k.secretKey(token, kid)
+	}
+
+	for _, key := range k.keys {
+		if key.kid != kid {
+			continue
+		}
+
+		if token.Method.Alg() != key.method.Alg() {
+			return nil, errors.Errorf("unexpected signing method %s for key %s", token.Method.Alg(), kid)
+		}
+
+		return key.private.Public(), nil
+	}
+
+	return nil, errors.Errorf("unknown key ID: %s", kid)
+}
+
+// secretKey 回傳驗證 HS256 token 的 JWTSecret：未設定 SigningKeys 時接受以 secretKID 簽發的 token，
+// 未帶 kid 的舊 token 與改用 SigningKeys 前簽發的 token 僅在 LegacyHS256Until 前接受
+func (k *KeySet) secretKey(token *jwt.Token, kid string) (any, error) {
+	if token.Method != jwt.SigningMethodHS256 || k.cfg.Auth.JWTSecret == "" {
+		return nil, errors.New("token has no usable key ID")
+	}
+
+	signingWithSecret := len(k.keys) == 0 && kid == secretKID
+	if !signingWithSecret && !time.Now().Before(k.cfg.Auth.LegacyHS256Until) {
+		return nil, errors.New("legacy HS256 tokens are no longer accepted")
+	}
+
+	return []byte(k.cfg.Auth.JWTSecret), nil
+}
+
+// ValidMethods 回傳驗證時允許的簽章演算法
+func (k *KeySet) ValidMethods() []string {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	methods := []string{}
+	if k.cfg.Auth.JWTSecret != "" {
+		methods = append(methods, jwt.SigningMethodHS256.Alg())
+	}
+	for _, key := range k.keys {
+		methods = append(methods, key.method.Alg())
+	}
+
+	return methods
+}
+
+// Parse 驗證 token 的簽章與有效期並解析至 claims
+func (k *KeySet) Parse(tokenString string, claims jwt.Claims) error {
+	token, err := jwt.ParseWithClaims(tokenString, claims, k.Keyfunc, jwt.WithValidMethods(k.ValidMethods()))
+	if err != nil {
+		return errors.Wrap(err, "failed to parse token")
+	}
+
+	if !token.Valid {
+		return errors.New("invalid token")
+	}
+
+	return nil
+}
+
+// JWKS 回傳所有驗證金鑰的公開金鑰
+func (k *KeySet) JWKS() JSONWebKeySet {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	keySet := JSONWebKeySet{Keys: make([]JSONWebKey, 0, len(k.keys))}
+	for _, key := range k.keys {
+		jwk := JSONWebKey{
+			KID: key.kid,
+			Alg: key.method.Alg(),
+			Use: "sig",
+		}
+
+		switch pub := key.private.Public().(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+		}
+
+		keySet.Keys = append(keySet.Keys, jwk)
+	}
+
+	return keySet
+}
+
+func loadSigningKey(keyConfig config.SigningKeyConfig) (*signingKey, error) {
+	if keyConfig.KID == "" {
+		return nil, errors.New("kid is required")
+	}
+
+	data, err := os.ReadFile(keyConfig.PrivateKeyPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read private key")
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("failed to decode PEM block")
+	}
+
+	var parsed any
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse private key")
+	}
+
+	switch private := parsed.(type) {
+	case *rsa.PrivateKey:
+		return &signingKey{kid: keyConfig.KID, method: jwt.SigningMethodRS256, private: private, activeFrom: keyConfig.ActiveFrom}, nil
+	case ed25519.PrivateKey:
+		return &signingKey{kid: keyConfig.KID, method: jwt.SigningMethodEdDSA, private: private, activeFrom: keyConfig.ActiveFrom}, nil
+	default:
+		return nil, errors.Errorf("unsupported private key type %T", parsed)
+	}
+}
@@ -0,0 +1,27 @@
+package notification
+
+import (
+	"context"
+	"log/slog"
+
+	"server-template/internal/domain/notification"
+)
+
+// logNotifier 將通知內容寫入日誌，僅供本地開發使用
+type logNotifier struct {
+	logger *slog.Logger
+}
+
+func newLogNotifier(logger *slog.Logger) notification.Notifier {
+	return &logNotifier{logger: logger}
+}
+
+func (n *logNotifier) Send(ctx context.Context, msg *notification.Message) error {
+	n.logger.InfoContext(ctx, "Notification sent",
+		slog.String("to", msg.To),
+		slog.String("subject", msg.Subject),
+		slog.String("body", msg.Body),
+	)
+
+	return nil
+}
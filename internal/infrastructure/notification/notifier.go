@@ -0,0 +1,38 @@
+package notification
+
+import (
+	"log/slog"
+
+	"server-template/config"
+	"server-template/internal/domain/notification"
+
+	"github.com/pkg/errors"
+	"go.uber.org/fx"
+)
+
+// Params 定義 notifier 所需的參數
+type Params struct {
+	fx.In
+
+	Config *config.Config
+	Logger *slog.Logger
+}
+
+// New 依設定創建 notifier，未設定時使用將通知寫入日誌的實作
+func New(params Params) (notification.Notifier, error) {
+	notifierType := notification.NotifierType(params.Config.Notification.Driver)
+	if notifierType == "" {
+		notifierType = notification.NotifierLog
+	}
+
+	if !notifierType.IsValid() {
+		return nil, errors.Errorf("unsupported notifier type: %s", notifierType)
+	}
+
+	switch notifierType {
+	case notification.NotifierLog:
+		return newLogNotifier(params.Logger), nil
+	default:
+		return nil, errors.Errorf("unsupported notifier type: %s", notifierType)
+	}
+}
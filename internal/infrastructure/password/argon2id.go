@@ -0,0 +1,138 @@
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/argon2"
+)
+
+// 未設定時採用 RFC 9106 第 4 節建議的第二組參數
+const (
+	defaultArgon2idMemory      = 64 * 1024 // KiB
+	defaultArgon2idIterations  = 3
+	defaultArgon2idParallelism = 4
+	defaultArgon2idSaltLength  = 16
+	defaultArgon2idKeyLength   = 32
+
+	argon2idPrefix = "$argon2id$"
+)
+
+type argon2idParams struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+	saltLength  uint32
+	keyLength   uint32
+}
+
+// argon2idHasher 產生 $argon2id$v=19$m=<memory>,t=<iterations>,p=<parallelism>$<salt>$<hash> 格式的雜湊值，
+// salt 與 hash 以不帶 padding 的標準 base64 編碼
+type argon2idHasher struct {
+	params argon2idParams
+}
+
+func newArgon2idHasher(memory, iterations uint32, parallelism uint8, saltLength, keyLength uint32) (*argon2idHasher, error) {
+	params := argon2idParams{
+		memory:      valueOrDefault(memory, defaultArgon2idMemory),
+		iterations:  valueOrDefault(iterations, defaultArgon2idIterations),
+		parallelism: valueOrDefault(parallelism, defaultArgon2idParallelism),
+		saltLength:  valueOrDefault(saltLength, defaultArgon2idSaltLength),
+		keyLength:   valueOrDefault(keyLength, defaultArgon2idKeyLength),
+	}
+
+	// RFC 9106 要求記憶體至少為 8*p KiB，salt 至少 8 位元組，輸出至少 4 位元組
+	if params.memory < 8*uint32(params.parallelism) {
+		return nil, errors.Errorf("argon2id memory must be at least %d KiB", 8*uint32(params.parallelism))
+	}
+	if params.saltLength < 8 || params.keyLength < 4 {
+		return nil, errors.New("argon2id salt length must be at least 8 and key length at least 4")
+	}
+
+	return &argon2idHasher{params: params}, nil
+}
+
+func (h *argon2idHasher) matches(encoded string) bool {
+	return strings.HasPrefix(encoded, argon2idPrefix)
+}
+
+func (h *argon2idHasher) Hash(plain string) (string, error) {
+	salt := make([]byte, h.params.saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", errors.Wrap(err, "failed to generate salt")
+	}
+
+	key := argon2.IDKey([]byte(plain), salt, h.params.iterations, h.params.memory, h.params.parallelism, h.params.keyLength)
+
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2idPrefix,
+		argon2.Version,
+		h.params.memory,
+		h.params.iterations,
+		h.params.parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func (h *argon2idHasher) Verify(plain, encoded string) (bool, bool, error) {
+	params, salt, key, err := decodeArgon2id(encoded)
+	if err != nil {
+		return false, false, err
+	}
+
+	// 以雜湊值本身的參數計算，使參數調整後舊的雜湊值仍可驗證
+	candidate := argon2.IDKey([]byte(plain), salt, params.iterations, params.memory, params.parallelism, params.keyLength)
+	if subtle.ConstantTimeCompare(candidate, key) != 1 {
+		return false, false, nil
+	}
+
+	return true, params != h.params, nil
+}
+
+func decodeArgon2id(encoded string) (argon2idParams, []byte, []byte, error) {
+	var params argon2idParams
+
+	// 切分後依序為 "", "argon2id", "v=19", "m=...,t=...,p=...", salt, hash
+	fields := strings.Split(encoded, "$")
+	if len(fields) != 6 {
+		return params, nil, nil, errors.New("invalid argon2id hash format")
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(fields[2], "v=%d", &version); err != nil {
+		return params, nil, nil, errors.Wrap(err, "invalid argon2id version")
+	}
+	if version != argon2.Version {
+		return params, nil, nil, errors.Errorf("unsupported argon2id version %d", version)
+	}
+
+	if _, err := fmt.Sscanf(fields[3], "m=%d,t=%d,p=%d", &params.memory, &params.iterations, &params.parallelism); err != nil {
+		return params, nil, nil, errors.Wrap(err, "invalid argon2id parameters")
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(fields[4])
+	if err != nil {
+		return params, nil, nil, errors.Wrap(err, "invalid argon2id salt")
+	}
+	key, err := base64.RawStdEncoding.DecodeString(fields[5])
+	if err != nil {
+		return params, nil, nil, errors.Wrap(err, "invalid argon2id hash")
+	}
+
+	params.saltLength, params.keyLength = uint32(len(salt)), uint32(len(key))
+
+	return params, salt, key, nil
+}
+
+func valueOrDefault[T uint8 | uint32 | int](value, fallback T) T {
+	if value == 0 {
+		return fallback
+	}
+
+	return value
+}
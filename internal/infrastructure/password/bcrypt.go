@@ -0,0 +1,47 @@
+package password
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// bcryptHasher 為 Argon2id 之前使用的演算法，保留以驗證既有的雜湊值
+type bcryptHasher struct {
+	cost int
+}
+
+func newBcryptHasher(cost int) *bcryptHasher {
+	return &bcryptHasher{cost: valueOrDefault(cost, bcrypt.DefaultCost)}
+}
+
+func (h *bcryptHasher) matches(encoded string) bool {
+	return strings.HasPrefix(encoded, "$2a$") || strings.HasPrefix(encoded, "$2b$") || strings.HasPrefix(encoded, "$2y$")
+}
+
+func (h *bcryptHasher) Hash(plain string) (string, error) {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(plain), h.cost)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to hash password")
+	}
+
+	return string(hashed), nil
+}
+
+func (h *bcryptHasher) Verify(plain, encoded string) (bool, bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(plain))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, false, nil
+	}
+	if err != nil {
+		return false, false, errors.Wrap(err, "failed to compare password")
+	}
+
+	cost, err := bcrypt.Cost([]byte(encoded))
+	if err != nil {
+		return false, false, errors.Wrap(err, "failed to read bcrypt cost")
+	}
+
+	return true, cost < h.cost, nil
+}
@@ -0,0 +1,64 @@
+package password
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// breachPrefixLength 為 k-anonymity 分組使用的 SHA-1 前綴長度
+const breachPrefixLength = 5
+
+// breachList 為本地的外洩密碼雜湊清單，格式與 Have I Been Pwned 的 range API 相同：
+// 目錄中每個 SHA-1 前五碼各有一個 <PREFIX>.txt 檔案，每行為 <SUFFIX>:<COUNT>。
+// 檢查時只讀取候選密碼所屬前綴的檔案，不需將整份清單載入記憶體。
+type breachList struct {
+	dir string
+}
+
+func newBreachList(dir string) (*breachList, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open breached password list")
+	}
+	if !info.IsDir() {
+		return nil, errors.Errorf("breached password list %s must be a directory of prefix files", dir)
+	}
+
+	return &breachList{dir: dir}, nil
+}
+
+// contains 表示密碼是否出現在外洩清單中，清單缺少對應的前綴檔案時視為未外洩
+func (b *breachList) contains(candidate string) (bool, error) {
+	// SHA-1 僅用於對應清單的格式，不作為密碼雜湊
+	sum := sha1.Sum([]byte(candidate))
+	digest := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := digest[:breachPrefixLength], digest[breachPrefixLength:]
+
+	file, err := os.Open(filepath.Join(b.dir, prefix+".txt"))
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrap(err, "failed to open breached password prefix file")
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		hash, _, _ := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if strings.EqualFold(hash, suffix) {
+			return true, nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return false, errors.Wrap(err, "failed to read breached password prefix file")
+	}
+
+	return false, nil
+}
@@ -0,0 +1,87 @@
+package password
+
+import (
+	"strings"
+
+	"server-template/config"
+	"server-template/internal/domain/password"
+
+	"github.com/pkg/errors"
+	"go.uber.org/fx"
+)
+
+// Params 定義 password hasher 所需的參數
+type Params struct {
+	fx.In
+
+	Config *config.Config
+}
+
+// algorithmHasher 為單一演算法的實作，matches 判斷雜湊值是否屬於該演算法
+type algorithmHasher interface {
+	password.Hasher
+	matches(encoded string) bool
+}
+
+// hasher 以設定的演算法產生雜湊值，驗證時依雜湊值的格式選擇演算法，使舊演算法的雜湊值仍可登入
+type hasher struct {
+	current    password.Algorithm
+	algorithms map[password.Algorithm]algorithmHasher
+}
+
+// New 依設定創建 password hasher，未設定時使用 Argon2id
+func New(params Params) (password.Hasher, error) {
+	cfg := params.Config.Auth.PasswordHashing
+
+	algorithm := password.Algorithm(cfg.Algorithm)
+	if algorithm == "" {
+		algorithm = password.AlgorithmArgon2id
+	}
+
+	if !algorithm.IsValid() {
+		return nil, errors.Errorf("unsupported password hashing algorithm: %s", algorithm)
+	}
+
+	argon2id, err := newArgon2idHasher(cfg.Argon2id.Memory, cfg.Argon2id.Iterations, cfg.Argon2id.Parallelism, cfg.Argon2id.SaltLength, cfg.Argon2id.KeyLength)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hasher{
+		current: algorithm,
+		algorithms: map[password.Algorithm]algorithmHasher{
+			password.AlgorithmArgon2id: argon2id,
+			password.AlgorithmBcrypt:   newBcryptHasher(cfg.BcryptCost),
+		},
+	}, nil
+}
+
+func (h *hasher) Hash(plain string) (string, error) {
+	return h.algorithms[h.current].Hash(plain)
+}
+
+func (h *hasher) Verify(plain, encoded string) (bool, bool, error) {
+	for algorithm, impl := range h.algorithms {
+		if !impl.matches(encoded) {
+			continue
+		}
+
+		match, needsRehash, err := impl.Verify(plain, encoded)
+		if err != nil || !match {
+			return false, false, err
+		}
+
+		return true, needsRehash || algorithm != h.current, nil
+	}
+
+	return false, false, errors.Errorf("unrecognized password hash format %q", hashIdentifier(encoded))
+}
+
+// hashIdentifier 回傳雜湊值開頭的演算法識別字，避免將完整雜湊值寫入錯誤訊息
+func hashIdentifier(encoded string) string {
+	if fields := strings.SplitN(encoded, "$", 3); len(fields) == 3 {
+		return fields[1]
+	}
+
+	return ""
+}
@@ -0,0 +1,171 @@
+package password
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"server-template/internal/domain/errs"
+	"server-template/internal/domain/password"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultMinLength = 8
+	defaultMaxLength = 128
+
+	// minDisallowedWordLength 以下的字詞過短，出現在密碼中不具意義，不予檢查
+	minDisallowedWordLength = 3
+)
+
+// characterClasses 為可要求的字元類別，判斷時涵蓋 Unicode 字元
+var characterClasses = map[string]struct {
+	description string
+	match       func(rune) bool
+}{
+	"upper":  {"an uppercase letter", unicode.IsUpper},
+	"lower":  {"a lowercase letter", unicode.IsLower},
+	"digit":  {"a number", unicode.IsDigit},
+	"symbol": {"a special character", func(r rune) bool { return unicode.IsPunct(r) || unicode.IsSymbol(r) }},
+}
+
+// characterClassOrder 為未設定時要求的字元類別，並決定違規項目的順序
+var characterClassOrder = []string{"upper", "lower", "digit", "symbol"}
+
+type policy struct {
+	minLength       int
+	maxLength       int
+	classes         []string
+	maxRepeats      int
+	disallowedWords []string
+	breaches        *breachList
+}
+
+// NewPolicy 依設定創建密碼政策，設定 BreachedPasswords.Path 時同時檢查密碼是否曾外洩
+func NewPolicy(params Params) (password.Policy, error) {
+	cfg := params.Config.Auth.PasswordPolicy
+
+	classes := cfg.CharacterClasses
+	if classes == nil {
+		classes = characterClassOrder
+	}
+	for _, class := range classes {
+		if _, ok := characterClasses[class]; !ok {
+			return nil, errors.Errorf("unsupported password character class: %s", class)
+		}
+	}
+
+	p := &policy{
+		minLength:       valueOrDefault(cfg.MinLength, defaultMinLength),
+		maxLength:       valueOrDefault(cfg.MaxLength, defaultMaxLength),
+		classes:         classes,
+		maxRepeats:      cfg.MaxRepeats,
+		disallowedWords: cfg.DisallowedWords,
+	}
+	if p.minLength > p.maxLength {
+		return nil, errors.Errorf("password min length %d exceeds max length %d", p.minLength, p.maxLength)
+	}
+
+	if cfg.BreachedPasswords.Path != "" {
+		breaches, err := newBreachList(cfg.BreachedPasswords.Path)
+		if err != nil {
+			return nil, err
+		}
+		p.breaches = breaches
+	}
+
+	return p, nil
+}
+
+func (p *policy) Validate(_ context.Context, field, candidate string, userInputs ...string) error {
+	var violations []errs.Violation
+	violate := func(description string) {
+		violations = append(violations, errs.Violation{Field: field, Description: description})
+	}
+
+	// 長度以 Unicode 字元計算
+	length := utf8.RuneCountInString(candidate)
+	if length < p.minLength {
+		violate(fmt.Sprintf("password must be at least %d characters long", p.minLength))
+	}
+	if length > p.maxLength {
+		violate(fmt.Sprintf("password must be at most %d characters long", p.maxLength))
+	}
+
+	for _, name := range p.classes {
+		class := characterClasses[name]
+		if !strings.ContainsFunc(candidate, class.match) {
+			violate("password must contain " + class.description)
+		}
+	}
+
+	if p.maxRepeats > 0 && longestRun(candidate) > p.maxRepeats {
+		violate(fmt.Sprintf("password must not repeat the same character more than %d times in a row", p.maxRepeats))
+	}
+
+	if word, ok := p.containedWord(candidate, userInputs); ok {
+		violate(fmt.Sprintf("password must not contain %q", word))
+	}
+
+	// 已違反其他規則時不需再查詢外洩清單
+	if len(violations) == 0 && p.breaches != nil {
+		breached, err := p.breaches.contains(candidate)
+		if err != nil {
+			return err
+		}
+		if breached {
+			violate("password has appeared in a data breach and must not be used")
+		}
+	}
+
+	if len(violations) > 0 {
+		return errs.Validation("password does not meet the password policy", violations...)
+	}
+
+	return nil
+}
+
+// containedWord 回傳密碼中出現的第一個禁用字詞，比對時不分大小寫；email 另外檢查其 @ 之前的部分
+func (p *policy) containedWord(candidate string, userInputs []string) (string, bool) {
+	lowered := strings.ToLower(candidate)
+
+	words := append([]string{}, p.disallowedWords...)
+	for _, input := range userInputs {
+		words = append(words, input)
+		if local, _, ok := strings.Cut(input, "@"); ok {
+			words = append(words, local)
+		}
+		words = append(words, strings.Fields(input)...)
+	}
+
+	for _, word := range words {
+		if utf8.RuneCountInString(word) < minDisallowedWordLength {
+			continue
+		}
+		if strings.Contains(lowered, strings.ToLower(word)) {
+			return word, true
+		}
+	}
+
+	return "", false
+}
+
+// longestRun 回傳連續重複同一字元的最大次數
+func longestRun(s string) int {
+	longest, run := 0, 0
+	var previous rune
+	for i, r := range []rune(s) {
+		if i > 0 && r == previous {
+			run++
+		} else {
+			run = 1
+		}
+		previous = r
+		longest = max(longest, run)
+	}
+
+	return longest
+}
@@ -0,0 +1,119 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+const sweepInterval = time.Minute
+
+// gcraScript 以 Redis 的時間執行 GCRA，避免各實例時鐘不同步；鍵的值為理論到達時間（TAT，微秒）。
+// 回傳 {allowed, remaining, retry_after, reset_after}，時間單位皆為微秒。
+var gcraScript = redis.NewScript(`
+local emission = tonumber(ARGV[1])
+local tolerance = tonumber(ARGV[2])
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000000 + tonumber(time[2])
+
+local tat = tonumber(redis.call('GET', KEYS[1])) or now
+if tat < now then
+	tat = now
+end
+
+local new_tat = tat + emission
+local allow_at = new_tat - tolerance
+if allow_at > now then
+	return {0, 0, allow_at - now, tat - now}
+end
+
+redis.call('SET', KEYS[1], string.format('%.0f', new_tat), 'PX', math.ceil((new_tat - now) / 1000))
+
+return {1, math.floor((now - allow_at) / emission), 0, new_tat - now}
+`)
+
+func allowRedis(ctx context.Context, client *redis.ClusterClient, rule *Rule, key string) (*Result, error) {
+	values, err := gcraScript.Run(ctx, client, []string{key}, rule.emission.Microseconds(), rule.tolerance.Microseconds()).Int64Slice()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to run rate limit script")
+	}
+	if len(values) != 4 {
+		return nil, errors.Errorf("unexpected rate limit script result %v", values)
+	}
+
+	return &Result{
+		Allowed:    values[0] == 1,
+		Limit:      rule.Limit,
+		Remaining:  int(values[1]),
+		RetryAfter: time.Duration(values[2]) * time.Microsecond,
+		ResetAfter: time.Duration(values[3]) * time.Microsecond,
+	}, nil
+}
+
+// memoryStore 為 Redis 無法使用時的本地 GCRA 實作，演算法與 gcraScript 相同
+type memoryStore struct {
+	mu   sync.Mutex
+	tats map[string]time.Time
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{tats: make(map[string]time.Time)}
+}
+
+func (s *memoryStore) allow(rule *Rule, key string, now time.Time) *Result {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tat, ok := s.tats[key]
+	if !ok || tat.Before(now) {
+		tat = now
+	}
+
+	newTAT := tat.Add(rule.emission)
+	allowAt := newTAT.Add(-rule.tolerance)
+	if allowAt.After(now) {
+		return &Result{
+			Limit:      rule.Limit,
+			RetryAfter: allowAt.Sub(now),
+			ResetAfter: tat.Sub(now),
+		}
+	}
+
+	s.tats[key] = newTAT
+
+	return &Result{
+		Allowed:    true,
+		Limit:      rule.Limit,
+		Remaining:  int(now.Sub(allowAt) / rule.emission),
+		ResetAfter: newTAT.Sub(now),
+	}
+}
+
+// sweep 移除額度已完全恢復的記錄
+func (s *memoryStore) sweep(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key, tat := range s.tats {
+		if !tat.After(now) {
+			delete(s.tats, key)
+		}
+	}
+}
+
+func (s *memoryStore) run(ctx context.Context) {
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.sweep(now)
+		}
+	}
+}
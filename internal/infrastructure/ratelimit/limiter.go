@@ -0,0 +1,272 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"server-template/config"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/fx"
+)
+
+// KeyType 為限流的對象，決定哪些請求共用同一份額度
+type KeyType string
+
+const (
+	KeyIP     KeyType = "ip"
+	KeyUser   KeyType = "user"
+	KeyAPIKey KeyType = "api_key"
+	// KeyRoute 表示同一路由的所有請求共用額度
+	KeyRoute KeyType = "route"
+)
+
+func (k KeyType) IsValid() bool {
+	switch k {
+	case KeyIP, KeyUser, KeyAPIKey, KeyRoute:
+		return true
+	default:
+		return false
+	}
+}
+
+const keyPrefix = "ratelimit"
+
+// redisRetryInterval 為 Redis 失敗後暫停使用的時間，期間的請求直接以本地記憶體限流，避免每個請求都等待 Redis 逾時
+const redisRetryInterval = 5 * time.Second
+
+// Rule 為一條 GCRA 限流規則，每 emission 補回一次額度，最多累積 burst 次
+type Rule struct {
+	Name   string
+	Routes []string
+	Key    KeyType
+	Limit  int
+
+	emission  time.Duration
+	tolerance time.Duration
+}
+
+// matches 表示規則是否套用至該路由：未設定路由時套用至所有請求，
+// 路由樣式結尾為 * 時比對前綴，HTTP 路由的方法可為 * 表示不限方法
+func (r *Rule) matches(route string) bool {
+	if len(r.Routes) == 0 {
+		return true
+	}
+
+	for _, pattern := range r.Routes {
+		if matchRoute(pattern, route) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchRoute(pattern, route string) bool {
+	if rest, ok := strings.CutPrefix(pattern, "* "); ok {
+		_, path, found := strings.Cut(route, " ")
+		if !found {
+			return false
+		}
+		pattern, route = rest, path
+	}
+
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(route, prefix)
+	}
+
+	return pattern == route
+}
+
+// Result 為一次限流檢查的結果，Limit 為可連續發出的請求數
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// ResetAfter 為額度完全恢復所需的時間
+	ResetAfter time.Duration
+	// RetryAfter 為被拒絕時需等待的時間，允許時為 0
+	RetryAfter time.Duration
+}
+
+// Stricter 回傳兩個結果中較嚴格者：被拒絕優先，其次為剩餘額度較少者，任一為 nil 時回傳另一個
+func Stricter(a, b *Result) *Result {
+	switch {
+	case a == nil:
+		return b
+	case b == nil:
+		return a
+	case a.Allowed != b.Allowed:
+		if !a.Allowed {
+			return a
+		}
+
+		return b
+	case !a.Allowed:
+		if b.RetryAfter > a.RetryAfter {
+			return b
+		}
+
+		return a
+	case b.Remaining < a.Remaining:
+		return b
+	default:
+		return a
+	}
+}
+
+// Limiter 以 Redis 執行 GCRA 限流，讓多個實例共用額度；
+// Redis 無法使用時改以本地記憶體限流，此時額度為每個實例各自計算
+type Limiter struct {
+	logger *slog.Logger
+	redis  *redis.ClusterClient
+	rules  []*Rule
+	local  *memoryStore
+
+	// degraded 表示目前是否因 Redis 無法使用而改用本地限流
+	degraded atomic.Bool
+	// retryAt 為 Redis 失敗後再次嘗試的時間（UnixNano），在此之前不呼叫 Redis
+	retryAt atomic.Int64
+}
+
+type Params struct {
+	fx.In
+	fx.Lifecycle
+
+	Config *config.Config
+	Logger *slog.Logger
+	Redis  *redis.ClusterClient
+}
+
+// New 依設定建立限流器，未啟用時回傳不限制任何請求的限流器
+func New(params Params) (*Limiter, error) {
+	limiter := &Limiter{
+		logger: params.Logger,
+		redis:  params.Redis,
+		local:  newMemoryStore(),
+	}
+
+	cfg := params.Config.RateLimit
+	if !cfg.Enable {
+		return limiter, nil
+	}
+
+	names := make(map[string]struct{}, len(cfg.Rules))
+	for _, ruleCfg := range cfg.Rules {
+		rule, err := newRule(ruleCfg)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := names[rule.Name]; ok {
+			return nil, errors.Errorf("duplicate rate limit rule %q", rule.Name)
+		}
+		names[rule.Name] = struct{}{}
+
+		limiter.rules = append(limiter.rules, rule)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	params.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go limiter.local.run(ctx)
+
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			cancel()
+
+			return nil
+		},
+	})
+
+	return limiter, nil
+}
+
+func newRule(cfg config.RateLimitRuleConfig) (*Rule, error) {
+	key := KeyType(cfg.Key)
+	switch {
+	case cfg.Name == "":
+		return nil, errors.New("rate limit rule name is required")
+	case !key.IsValid():
+		return nil, errors.Errorf("rate limit rule %q has invalid key %q", cfg.Name, cfg.Key)
+	case cfg.Rate <= 0 || cfg.Period <= 0:
+		return nil, errors.Errorf("rate limit rule %q requires a positive rate and period", cfg.Name)
+	case cfg.Burst < 0:
+		return nil, errors.Errorf("rate limit rule %q has negative burst", cfg.Name)
+	}
+
+	burst := cfg.Burst
+	if burst == 0 {
+		burst = cfg.Rate
+	}
+
+	// Redis 端以微秒計算，間隔需至少 1 微秒
+	emission := (cfg.Period / time.Duration(cfg.Rate)).Truncate(time.Microsecond)
+	if emission <= 0 {
+		return nil, errors.Errorf("rate limit rule %q rate is too high for its period", cfg.Name)
+	}
+
+	return &Rule{
+		Name:      cfg.Name,
+		Routes:    cfg.Routes,
+		Key:       key,
+		Limit:     burst,
+		emission:  emission,
+		tolerance: emission * time.Duration(burst),
+	}, nil
+}
+
+// Check 以所有符合路由的規則檢查請求，回傳最嚴格的結果。
+// keys 為呼叫端可識別的限流對象，不在其中或值為空的規則會略過，KeyRoute 一律以路由本身為對象；
+// 沒有任何規則套用時回傳 nil。
+func (l *Limiter) Check(ctx context.Context, route string, keys map[KeyType]string) *Result {
+	var result *Result
+	for _, rule := range l.rules {
+		if !rule.matches(route) {
+			continue
+		}
+
+		id := route
+		if rule.Key != KeyRoute {
+			if id = keys[rule.Key]; id == "" {
+				continue
+			}
+		}
+
+		result = Stricter(result, l.allow(ctx, rule, fmt.Sprintf("%s:%s:%s", keyPrefix, rule.Name, id)))
+	}
+
+	return result
+}
+
+func (l *Limiter) allow(ctx context.Context, rule *Rule, key string) *Result {
+	now := time.Now()
+	if l.degraded.Load() && now.UnixNano() < l.retryAt.Load() {
+		return l.local.allow(rule, key, now)
+	}
+
+	result, err := allowRedis(ctx, l.redis, rule, key)
+	if err == nil {
+		if l.degraded.CompareAndSwap(true, false) {
+			l.logger.InfoContext(ctx, "Rate limiter recovered, using Redis")
+		}
+
+		return result
+	}
+
+	// 請求本身被取消時不視為 Redis 無法使用
+	if ctx.Err() == nil {
+		l.retryAt.Store(now.Add(redisRetryInterval).UnixNano())
+		if l.degraded.CompareAndSwap(false, true) {
+			l.logger.WarnContext(ctx, "Rate limiter falling back to local memory", slog.Any("error", err))
+		}
+	}
+
+	return l.local.allow(rule, key, time.Now())
+}
@@ -0,0 +1,271 @@
+// Package redistest 提供測試用的記憶體 Redis 伺服器，以 RESP2 實作字串、sorted set 與 pub/sub 的基本指令，
+// 並回傳指向它的 ClusterClient；不支援的指令回傳錯誤
+package redistest
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Server 為程序內的 Redis 伺服器，所有 slot 皆由同一個節點負責
+type Server struct {
+	listener net.Listener
+
+	mu          sync.Mutex
+	strings     map[string]string
+	sortedSets  map[string]map[string]float64
+	subscribers map[string][]*conn
+}
+
+// NewServer 啟動伺服器，測試結束時自動關閉
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+
+	server := &Server{
+		listener:    listener,
+		strings:     make(map[string]string),
+		sortedSets:  make(map[string]map[string]float64),
+		subscribers: make(map[string][]*conn),
+	}
+	go server.serve()
+	t.Cleanup(func() { _ = listener.Close() })
+
+	return server
+}
+
+// Client 回傳連線至伺服器的 ClusterClient，測試結束時自動關閉
+func (s *Server) Client(t testing.TB) *redis.ClusterClient {
+	t.Helper()
+
+	addr := s.listener.Addr().String()
+	client := redis.NewClusterClient(&redis.ClusterOptions{
+		ClusterSlots: func(context.Context) ([]redis.ClusterSlot, error) {
+			return []redis.ClusterSlot{{Start: 0, End: 16383, Nodes: []redis.ClusterNode{{Addr: addr}}}}, nil
+		},
+		Protocol:        2,
+		DisableIdentity: true,
+	})
+	t.Cleanup(func() { _ = client.Close() })
+
+	return client
+}
+
+// Get 回傳字串鍵的值，鍵不存在時 ok 為 false
+func (s *Server) Get(key string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value, ok := s.strings[key]
+
+	return value, ok
+}
+
+func (s *Server) serve() {
+	for {
+		netConn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		go s.handle(&conn{Conn: netConn})
+	}
+}
+
+func (s *Server) handle(c *conn) {
+	defer s.unsubscribe(c)
+	defer c.Close()
+
+	reader := bufio.NewReader(c)
+	for {
+		args, err := readCommand(reader)
+		if err != nil {
+			return
+		}
+
+		s.execute(c, args)
+	}
+}
+
+func (s *Server) execute(c *conn, args []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch name, args := strings.ToUpper(args[0]), args[1:]; {
+	case name == "PING" && c.subscribed:
+		c.write(array(bulk("pong"), bulk("")))
+	case name == "PING":
+		c.write("+PONG\r\n")
+	case name == "GET" && len(args) == 1:
+		value, ok := s.strings[args[0]]
+		if !ok {
+			c.write("$-1\r\n")
+
+			return
+		}
+		c.write(bulk(value))
+	case name == "SET" && len(args) >= 2:
+		s.strings[args[0]] = args[1]
+		c.write("+OK\r\n")
+	case name == "DEL" || name == "EXISTS":
+		var count int
+		for _, key := range args {
+			_, isString := s.strings[key]
+			_, isSortedSet := s.sortedSets[key]
+			if isString || isSortedSet {
+				count++
+			}
+			if name == "DEL" {
+				delete(s.strings, key)
+				delete(s.sortedSets, key)
+			}
+		}
+		c.write(integer(count))
+	case name == "EXPIRE" || name == "PEXPIRE":
+		// 測試期間不處理過期
+		c.write(integer(1))
+	case name == "ZADD" && len(args) >= 3 && len(args)%2 == 1:
+		members := s.sortedSets[args[0]]
+		if members == nil {
+			members = make(map[string]float64)
+			s.sortedSets[args[0]] = members
+		}
+		var added int
+		for i := 1; i < len(args); i += 2 {
+			score, err := strconv.ParseFloat(args[i], 64)
+			if err != nil {
+				c.write("-ERR value is not a valid float\r\n")
+
+				return
+			}
+			if _, ok := members[args[i+1]]; !ok {
+				added++
+			}
+			members[args[i+1]] = score
+		}
+		c.write(integer(added))
+	case name == "ZREMRANGEBYSCORE" && len(args) == 3:
+		// 測試期間的項目皆未過期
+		c.write(integer(0))
+	case name == "ZRANGE" && len(args) == 3:
+		members := make([]string, 0, len(s.sortedSets[args[0]]))
+		for member := range s.sortedSets[args[0]] {
+			members = append(members, bulk(member))
+		}
+		slices.Sort(members)
+		c.write(array(members...))
+	case name == "PUBLISH" && len(args) == 2:
+		subscribers := s.subscribers[args[0]]
+		for _, subscriber := range subscribers {
+			subscriber.write(array(bulk("message"), bulk(args[0]), bulk(args[1])))
+		}
+		c.write(integer(len(subscribers)))
+	case name == "SUBSCRIBE" && len(args) > 0:
+		c.subscribed = true
+		for _, channel := range args {
+			if !slices.Contains(s.subscribers[channel], c) {
+				s.subscribers[channel] = append(s.subscribers[channel], c)
+				c.channels++
+			}
+			c.write(array(bulk("subscribe"), bulk(channel), integer(c.channels)))
+		}
+	default:
+		c.write(fmt.Sprintf("-ERR unknown command '%s'\r\n", name))
+	}
+}
+
+func (s *Server) unsubscribe(c *conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for channel, subscribers := range s.subscribers {
+		s.subscribers[channel] = slices.DeleteFunc(subscribers, func(subscriber *conn) bool {
+			return subscriber == c
+		})
+	}
+}
+
+// conn 為一條用戶端連線，發佈訊息與回覆可能來自不同 goroutine，寫入時需加鎖
+type conn struct {
+	net.Conn
+
+	writeMu    sync.Mutex
+	subscribed bool
+	channels   int
+}
+
+func (c *conn) write(reply string) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	_, _ = io.WriteString(c.Conn, reply)
+}
+
+// readCommand 讀取以 RESP 陣列送出的指令
+func readCommand(reader *bufio.Reader) ([]string, error) {
+	line, err := readLine(reader)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(line, "*") {
+		return nil, fmt.Errorf("unexpected command %q", line)
+	}
+
+	count, err := strconv.Atoi(line[1:])
+	if err != nil || count < 1 {
+		return nil, fmt.Errorf("invalid command length %q", line)
+	}
+
+	args := make([]string, count)
+	for i := range args {
+		line, err := readLine(reader)
+		if err != nil {
+			return nil, err
+		}
+
+		size, err := strconv.Atoi(strings.TrimPrefix(line, "$"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid bulk length %q", line)
+		}
+
+		buf := make([]byte, size+2)
+		if _, err := io.ReadFull(reader, buf); err != nil {
+			return nil, err
+		}
+		args[i] = string(buf[:size])
+	}
+
+	return args, nil
+}
+
+func readLine(reader *bufio.Reader) (string, error) {
+	line, err := reader.ReadString('\n')
+
+	return strings.TrimSuffix(line, "\r\n"), err
+}
+
+func bulk(value string) string {
+	return fmt.Sprintf("$%d\r\n%s\r\n", len(value), value)
+}
+
+func integer(value int) string {
+	return fmt.Sprintf(":%d\r\n", value)
+}
+
+func array(elements ...string) string {
+	return fmt.Sprintf("*%d\r\n%s", len(elements), strings.Join(elements, ""))
+}
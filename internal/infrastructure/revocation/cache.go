@@ -0,0 +1,196 @@
+package revocation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"server-template/config"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/fx"
+)
+
+const (
+	// Channel 為 token 撤銷事件的 Redis pub/sub 頻道
+	Channel = "token_revocations"
+
+	sweepInterval = time.Minute
+
+	// defaultWarmup 與 access token 的預設有效期相同
+	defaultWarmup = 15 * time.Minute
+)
+
+// Event 為 token 被撤銷時發佈的事件
+type Event struct {
+	TokenID   string `json:"token_id"`
+	ExpiresAt int64  `json:"expires_at"`
+}
+
+// SessionID 回傳工作階段被撤銷時使用的識別值，該工作階段的所有 token 皆視為已撤銷
+func SessionID(sessionID string) string {
+	return fmt.Sprintf("session:%s", sessionID)
+}
+
+// Publish 發佈 token 撤銷事件
+func Publish(ctx context.Context, client *redis.ClusterClient, tokenID string, expiresAt time.Time) error {
+	payload, err := json.Marshal(Event{
+		TokenID:   tokenID,
+		ExpiresAt: expiresAt.Unix(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal revocation event")
+	}
+
+	return errors.Wrap(client.Publish(ctx, Channel, payload).Err(), "failed to publish revocation event")
+}
+
+// Cache 為本地的 token 撤銷快取，透過 Redis pub/sub 接收撤銷事件，
+// 每筆記錄只保留到 token 本身過期為止
+type Cache struct {
+	logger *slog.Logger
+	redis  *redis.ClusterClient
+	warmup time.Duration
+
+	mu      sync.RWMutex
+	entries map[string]time.Time
+
+	// readyAt 為快取開始可信任的時間（UnixNano），0 表示尚未訂閱
+	readyAt atomic.Int64
+}
+
+type Params struct {
+	fx.In
+	fx.Lifecycle
+
+	Config *config.Config
+	Logger *slog.Logger
+	Redis  *redis.ClusterClient
+}
+
+// New 創建撤銷快取，僅在啟用本地驗證時訂閱撤銷事件
+func New(params Params) *Cache {
+	cache := &Cache{
+		logger:  params.Logger,
+		redis:   params.Redis,
+		warmup:  params.Config.Auth.AccessTokenTTL,
+		entries: make(map[string]time.Time),
+	}
+	if cache.warmup <= 0 {
+		cache.warmup = defaultWarmup
+	}
+
+	if !params.Config.Auth.LocalVerification {
+		return cache
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	params.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go cache.run(ctx)
+
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			cancel()
+
+			return nil
+		},
+	})
+
+	return cache
+}
+
+// Ready 表示快取是否可信任；未就緒時呼叫端應改用遠端驗證。
+// 訂閱前被撤銷的 token 不會出現在快取中，因此每次（重新）訂閱後需等待一個 access token 有效期。
+func (c *Cache) Ready() bool {
+	readyAt := c.readyAt.Load()
+
+	return readyAt != 0 && time.Now().UnixNano() >= readyAt
+}
+
+// IsRevoked 檢查 token 是否已被撤銷
+func (c *Cache) IsRevoked(tokenID string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	expiresAt, ok := c.entries[tokenID]
+
+	return ok && time.Now().Before(expiresAt)
+}
+
+func (c *Cache) add(tokenID string, expiresAt time.Time) {
+	if !time.Now().Before(expiresAt) {
+		return
+	}
+
+	c.mu.Lock()
+	c.entries[tokenID] = expiresAt
+	c.mu.Unlock()
+}
+
+// sweep 移除已過期的記錄
+func (c *Cache) sweep() {
+	now := time.Now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for tokenID, expiresAt := range c.entries {
+		if !now.Before(expiresAt) {
+			delete(c.entries, tokenID)
+		}
+	}
+}
+
+func (c *Cache) run(ctx context.Context) {
+	pubsub := c.redis.Subscribe(ctx, Channel)
+	defer pubsub.Close()
+	defer c.readyAt.Store(0)
+
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+
+	messages := pubsub.ChannelWithSubscriptions()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.sweep()
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+
+			c.handle(msg)
+		}
+	}
+}
+
+func (c *Cache) handle(msg any) {
+	switch msg := msg.(type) {
+	case *redis.Subscription:
+		// 重新連線時也會收到訂閱確認，斷線期間可能遺漏事件，因此重新計算就緒時間
+		if msg.Kind == "subscribe" {
+			c.readyAt.Store(time.Now().Add(c.warmup).UnixNano())
+		} else {
+			c.readyAt.Store(0)
+		}
+		c.logger.Info("Token revocation cache subscription changed", slog.String("kind", msg.Kind))
+	case *redis.Message:
+		var event Event
+		if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+			c.logger.Warn("Failed to decode revocation event", slog.Any("error", err))
+
+			return
+		}
+
+		c.add(event.TokenID, time.Unix(event.ExpiresAt, 0))
+	}
+}
@@ -3,6 +3,7 @@ package rpc
 import (
 	"context"
 	"fmt"
+	"log/slog"
 
 	"server-template/config"
 
@@ -10,7 +11,6 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.uber.org/fx"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 // ClientKey 定義支持的 RPC 客戶端類型
@@ -30,6 +30,7 @@ type Params struct {
 	fx.Lifecycle
 
 	Config *config.Config
+	Logger *slog.Logger
 }
 
 // New 創建 RPC 客戶端管理器
@@ -39,15 +40,20 @@ func New(params Params) (*Clients, error) {
 	}
 
 	opts := []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(UnaryClientRequestIDInterceptor(), UnaryClientErrorInterceptor()),
 	}
 	if params.Config.Observability.Otel.Enable {
 		opts = append(opts, grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
 	}
 
-	// 遍歷配置創建客戶端
+	// 遍歷配置創建客戶端，各客戶端依自己的 TLS 設定連線
 	for clientName, clientConfig := range params.Config.RPC.Clients {
-		clientConn, err := grpc.NewClient(clientConfig.Target, opts...)
+		creds, err := ClientCredentials(clientConfig.TLS, params.Logger)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create RPC client credentials: %s", clientName)
+		}
+
+		clientConn, err := grpc.NewClient(clientConfig.Target, append(opts, grpc.WithTransportCredentials(creds))...)
 		if err != nil {
 			return nil, errors.WithStack(err)
 		}
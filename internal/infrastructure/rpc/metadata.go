@@ -0,0 +1,42 @@
+package rpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestIDKey 為傳遞請求 ID 的 metadata 鍵，與 HTTP 的 X-Request-Id 標頭對應
+const RequestIDKey = "x-request-id"
+
+type requestIDContextKey struct{}
+
+// WithAuthorization 將呼叫者的 Authorization 標頭（Bearer token 或 ApiKey）放入 authorization metadata，
+// 供需要 required_permission 的方法驗證呼叫端權限
+func WithAuthorization(ctx context.Context, authorization string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", authorization)
+}
+
+// WithRequestID 將請求 ID 放入 context，透過 UnaryClientRequestIDInterceptor 傳遞給下游服務
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey{}, requestID)
+}
+
+// RequestIDFromContext 回傳 context 中的請求 ID，未設定時回傳空字串
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDContextKey{}).(string)
+
+	return requestID
+}
+
+// UnaryClientRequestIDInterceptor 將 context 中的請求 ID 放入 x-request-id metadata
+func UnaryClientRequestIDInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if requestID := RequestIDFromContext(ctx); requestID != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, RequestIDKey, requestID)
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
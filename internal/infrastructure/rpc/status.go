@@ -0,0 +1,200 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/errs"
+
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// ErrorDomain 為 ErrorInfo 的 domain，用於辨識由本服務產生的領域錯誤
+const ErrorDomain = "server-template"
+
+// ReasonRateLimited 為限流拒絕的 ErrorInfo reason，用於與登入封鎖區分
+const ReasonRateLimited = "RATE_LIMITED"
+
+// CodeOf 回傳領域錯誤類型對應的 gRPC 狀態碼
+func CodeOf(kind errs.Kind) codes.Code {
+	switch kind {
+	case errs.KindNotFound:
+		return codes.NotFound
+	case errs.KindAlreadyExists:
+		return codes.AlreadyExists
+	case errs.KindInvalidCredentials:
+		return codes.Unauthenticated
+	case errs.KindValidationFailed:
+		return codes.InvalidArgument
+	case errs.KindUnavailable:
+		return codes.Unavailable
+	case errs.KindPermissionDenied:
+		return codes.PermissionDenied
+	case errs.KindFailedPrecondition:
+		return codes.FailedPrecondition
+	default:
+		return codes.Internal
+	}
+}
+
+// KindOf 回傳 gRPC 狀態碼對應的領域錯誤類型，用於沒有 ErrorInfo 的狀態
+func KindOf(code codes.Code) errs.Kind {
+	switch code {
+	case codes.NotFound:
+		return errs.KindNotFound
+	case codes.AlreadyExists:
+		return errs.KindAlreadyExists
+	case codes.Unauthenticated:
+		return errs.KindInvalidCredentials
+	case codes.InvalidArgument:
+		return errs.KindValidationFailed
+	case codes.Unavailable:
+		return errs.KindUnavailable
+	case codes.PermissionDenied:
+		return errs.KindPermissionDenied
+	case codes.FailedPrecondition:
+		return errs.KindFailedPrecondition
+	default:
+		return errs.KindUnknown
+	}
+}
+
+// ToStatus 將錯誤轉換為 gRPC 狀態：領域錯誤帶有 ErrorInfo 與欄位驗證細節，
+// 限流與登入封鎖帶有 RetryInfo，其餘未歸類的錯誤一律為 INTERNAL 且不透露內部訊息
+func ToStatus(err error) *status.Status {
+	if st, ok := status.FromError(err); ok {
+		return st
+	}
+
+	if limited := new(entity.RateLimitedError); errors.As(err, &limited) {
+		return withDetails(
+			status.New(codes.ResourceExhausted, limited.Error()),
+			&errdetails.ErrorInfo{Reason: ReasonRateLimited, Domain: ErrorDomain},
+			&errdetails.RetryInfo{RetryDelay: durationpb.New(limited.RetryAfter)},
+		)
+	}
+
+	if throttled := new(entity.LoginThrottledError); errors.As(err, &throttled) {
+		return withDetails(
+			status.New(codes.ResourceExhausted, throttled.Error()),
+			&errdetails.RetryInfo{RetryDelay: durationpb.New(throttled.RetryAfter)},
+		)
+	}
+
+	if domainErr, ok := errs.As(err); ok && domainErr.Kind != errs.KindUnknown {
+		// 底層錯誤僅供伺服器端記錄，不回傳給呼叫端
+		details := []protoadapt.MessageV1{&errdetails.ErrorInfo{Reason: string(domainErr.Kind), Domain: ErrorDomain}}
+		if len(domainErr.Violations) > 0 {
+			badRequest := new(errdetails.BadRequest)
+			for _, violation := range domainErr.Violations {
+				badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+					Field:       violation.Field,
+					Description: violation.Description,
+				})
+			}
+			details = append(details, badRequest)
+		}
+
+		return withDetails(status.New(CodeOf(domainErr.Kind), domainErr.PublicMessage()), details...)
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.New(codes.Canceled, "request canceled")
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.New(codes.DeadlineExceeded, "deadline exceeded")
+	default:
+		return status.New(codes.Internal, "internal error")
+	}
+}
+
+// FromStatus 將 gRPC 狀態錯誤還原為領域錯誤，無法還原時回傳原錯誤
+func FromStatus(err error) error {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() == codes.OK {
+		return err
+	}
+
+	if st.Code() == codes.ResourceExhausted {
+		if err := resourceExhausted(st); err != nil {
+			return err
+		}
+	}
+
+	kind := KindOf(st.Code())
+	var violations []errs.Violation
+	for _, detail := range st.Details() {
+		switch detail := detail.(type) {
+		case *errdetails.ErrorInfo:
+			if detail.GetDomain() == ErrorDomain {
+				kind = errs.Kind(detail.GetReason())
+			}
+		case *errdetails.BadRequest:
+			for _, violation := range detail.GetFieldViolations() {
+				violations = append(violations, errs.Violation{
+					Field:       violation.GetField(),
+					Description: violation.GetDescription(),
+				})
+			}
+		}
+	}
+
+	if kind == errs.KindUnknown {
+		return err
+	}
+
+	domainErr := errs.New(kind, st.Message())
+	domainErr.Violations = violations
+
+	return domainErr
+}
+
+// resourceExhausted 依 ErrorInfo 還原為限流或登入封鎖錯誤，沒有 RetryInfo 時回傳 nil
+func resourceExhausted(st *status.Status) error {
+	var (
+		retryAfter  time.Duration
+		hasRetry    bool
+		rateLimited bool
+	)
+	for _, detail := range st.Details() {
+		switch detail := detail.(type) {
+		case *errdetails.RetryInfo:
+			retryAfter, hasRetry = detail.GetRetryDelay().AsDuration(), true
+		case *errdetails.ErrorInfo:
+			rateLimited = detail.GetDomain() == ErrorDomain && detail.GetReason() == ReasonRateLimited
+		}
+	}
+
+	switch {
+	case !hasRetry:
+		return nil
+	case rateLimited:
+		return &entity.RateLimitedError{RetryAfter: retryAfter}
+	default:
+		return &entity.LoginThrottledError{RetryAfter: retryAfter}
+	}
+}
+
+// UnaryClientErrorInterceptor 將呼叫其他服務時收到的 gRPC 狀態還原為領域錯誤
+func UnaryClientErrorInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return FromStatus(invoker(ctx, method, req, reply, cc, opts...))
+	}
+}
+
+// withDetails 附加錯誤細節，附加失敗時回傳不含細節的狀態
+func withDetails(st *status.Status, details ...protoadapt.MessageV1) *status.Status {
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
+		return st
+	}
+
+	return detailed
+}
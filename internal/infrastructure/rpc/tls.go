@@ -0,0 +1,295 @@
+package rpc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"server-template/config"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/peer"
+)
+
+const defaultTLSReloadInterval = time.Minute
+
+// PeerIdentity 為通過 mTLS 驗證的用戶端憑證身分
+type PeerIdentity struct {
+	CommonName string
+	DNSNames   []string
+	// URIs 為憑證的 URI SAN，例如 SPIFFE ID
+	URIs []string
+}
+
+// Name 回傳用於記錄與授權判斷的名稱，優先使用第一個 URI SAN，否則為 CommonName
+func (i *PeerIdentity) Name() string {
+	if len(i.URIs) > 0 {
+		return i.URIs[0]
+	}
+
+	return i.CommonName
+}
+
+// PeerIdentityFromContext 回傳 gRPC 呼叫端通過驗證的用戶端憑證身分，連線未使用 mTLS 時 ok 為 false
+func PeerIdentityFromContext(ctx context.Context) (*PeerIdentity, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return nil, false
+	}
+
+	leaf := info.State.VerifiedChains[0][0]
+	identity := &PeerIdentity{
+		CommonName: leaf.Subject.CommonName,
+		DNSNames:   leaf.DNSNames,
+	}
+	for _, uri := range leaf.URIs {
+		identity.URIs = append(identity.URIs, uri.String())
+	}
+
+	return identity, true
+}
+
+// ServerCredentials 依設定建立 gRPC 伺服器的傳輸憑證，未啟用 TLS 時回傳 nil；
+// 設定 ClientCAFile 時要求並驗證用戶端憑證（mTLS）
+func ServerCredentials(cfg config.RPCServerTLSConfig, logger *slog.Logger) (credentials.TransportCredentials, error) {
+	if !cfg.Enable {
+		return nil, nil
+	}
+	if cfg.CertFile == "" || cfg.KeyFile == "" {
+		return nil, errors.New("rpc server TLS requires certFile and keyFile")
+	}
+
+	source, err := newCertificateSource(cfg.CertFile, cfg.KeyFile, cfg.ClientCAFile, cfg.ReloadInterval, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// 每次交握取得目前的憑證與 CA，檔案更新後不需重啟
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, pool := source.current()
+
+			tlsConfig := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+				NextProtos:   []string{"h2"},
+			}
+			if pool != nil {
+				tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+				tlsConfig.ClientCAs = pool
+			}
+
+			return tlsConfig, nil
+		},
+	}), nil
+}
+
+// ClientCredentials 依設定建立 RPC 客戶端的傳輸憑證，未啟用 TLS 時為不加密的連線
+func ClientCredentials(cfg config.RPCClientTLSConfig, logger *slog.Logger) (credentials.TransportCredentials, error) {
+	if !cfg.Enable {
+		return insecure.NewCredentials(), nil
+	}
+	if (cfg.CertFile == "") != (cfg.KeyFile == "") {
+		return nil, errors.New("rpc client TLS requires both certFile and keyFile for mTLS")
+	}
+	// 自行驗證伺服器憑證時無法取得 target 的主機名稱，需明確指定
+	if cfg.CAFile != "" && cfg.ServerName == "" {
+		return nil, errors.New("rpc client TLS requires serverName when caFile is set")
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: cfg.ServerName,
+	}
+	if cfg.CertFile == "" && cfg.CAFile == "" {
+		return credentials.NewTLS(tlsConfig), nil
+	}
+
+	source, err := newCertificateSource(cfg.CertFile, cfg.KeyFile, cfg.CAFile, cfg.ReloadInterval, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.CertFile != "" {
+		tlsConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _ := source.current()
+
+			return cert, nil
+		}
+	}
+
+	if cfg.CAFile != "" {
+		// RootCAs 在建立連線設定時即固定，為了讓 CA 也能重新載入，改為在 VerifyConnection 中以目前的 CA 自行驗證
+		tlsConfig.InsecureSkipVerify = true
+		tlsConfig.VerifyConnection = func(state tls.ConnectionState) error {
+			_, pool := source.current()
+
+			return verifyServerCertificate(state, cfg.ServerName, pool)
+		}
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// verifyServerCertificate 執行與 crypto/tls 預設相同的伺服器憑證驗證，憑證需對 serverName 有效；
+// 不使用 state.ServerName，其在 target 為 IP 位址時為空字串，會略過主機名稱檢查
+func verifyServerCertificate(state tls.ConnectionState, serverName string, roots *x509.CertPool) error {
+	if len(state.PeerCertificates) == 0 {
+		return errors.New("server did not present a certificate")
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range state.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	_, err := state.PeerCertificates[0].Verify(x509.VerifyOptions{
+		DNSName:       serverName,
+		Roots:         roots,
+		Intermediates: intermediates,
+	})
+
+	return errors.Wrap(err, "failed to verify server certificate")
+}
+
+// certificateSource 從磁碟載入憑證與 CA bundle，檔案的修改時間改變時重新載入；
+// 重新載入失敗時繼續使用原本的憑證，避免寫入到一半的檔案中斷服務
+type certificateSource struct {
+	certFile string
+	keyFile  string
+	caFile   string
+	interval time.Duration
+	logger   *slog.Logger
+
+	mu        sync.Mutex
+	checkedAt time.Time
+	modTimes  map[string]time.Time
+	cert      *tls.Certificate
+	pool      *x509.CertPool
+}
+
+func newCertificateSource(certFile, keyFile, caFile string, interval time.Duration, logger *slog.Logger) (*certificateSource, error) {
+	if interval <= 0 {
+		interval = defaultTLSReloadInterval
+	}
+
+	source := &certificateSource{
+		certFile: certFile,
+		keyFile:  keyFile,
+		caFile:   caFile,
+		interval: interval,
+		logger:   logger,
+	}
+
+	modTimes, err := source.stat()
+	if err != nil {
+		return nil, err
+	}
+	if err := source.load(modTimes); err != nil {
+		return nil, err
+	}
+	source.checkedAt = time.Now()
+
+	return source, nil
+}
+
+// current 回傳目前的憑證與 CA，距上次檢查超過 interval 時先檢查檔案是否更新
+func (s *certificateSource) current() (*tls.Certificate, *x509.CertPool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if time.Since(s.checkedAt) < s.interval {
+		return s.cert, s.pool
+	}
+	s.checkedAt = time.Now()
+
+	modTimes, err := s.stat()
+	if err == nil && !s.changed(modTimes) {
+		return s.cert, s.pool
+	}
+	if err == nil {
+		err = s.load(modTimes)
+	}
+
+	if err != nil {
+		s.logger.Warn("Failed to reload TLS certificates, keeping the current ones", slog.Any("error", err))
+	} else {
+		s.logger.Info("Reloaded TLS certificates", slog.String("cert_file", s.certFile), slog.String("ca_file", s.caFile))
+	}
+
+	return s.cert, s.pool
+}
+
+func (s *certificateSource) files() []string {
+	var files []string
+	for _, file := range []string{s.certFile, s.keyFile, s.caFile} {
+		if file != "" {
+			files = append(files, file)
+		}
+	}
+
+	return files
+}
+
+func (s *certificateSource) stat() (map[string]time.Time, error) {
+	modTimes := make(map[string]time.Time, 3)
+	for _, file := range s.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to stat TLS file")
+		}
+		modTimes[file] = info.ModTime()
+	}
+
+	return modTimes, nil
+}
+
+func (s *certificateSource) changed(modTimes map[string]time.Time) bool {
+	for file, modTime := range modTimes {
+		if !modTime.Equal(s.modTimes[file]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *certificateSource) load(modTimes map[string]time.Time) error {
+	var cert *tls.Certificate
+	if s.certFile != "" {
+		loaded, err := tls.LoadX509KeyPair(s.certFile, s.keyFile)
+		if err != nil {
+			return errors.Wrap(err, "failed to load TLS key pair")
+		}
+		cert = &loaded
+	}
+
+	var pool *x509.CertPool
+	if s.caFile != "" {
+		pem, err := os.ReadFile(s.caFile)
+		if err != nil {
+			return errors.Wrap(err, "failed to read CA bundle")
+		}
+
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return errors.Errorf("no certificates found in CA bundle %s", s.caFile)
+		}
+	}
+
+	s.cert, s.pool, s.modTimes = cert, pool, modTimes
+
+	return nil
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"server-template/internal/domain/repository"
+	"server-template/internal/infrastructure/rpc"
+	"server-template/proto/pb/adminpb"
+
+	"github.com/pkg/errors"
+)
+
+// NewAdminRPC 建立 Admin 服務的客戶端，Admin 服務與 Auth 服務由同一個 gRPC server 提供
+func NewAdminRPC(rpcClients *rpc.Clients) (repository.AdminRPCRepository, error) {
+	client, err := rpcClients.GetClient(rpc.AuthClient)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return adminpb.NewAdminClient(client), nil
+}
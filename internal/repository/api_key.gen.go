@@ -0,0 +1,102 @@
+// Code generated by proxy-generator. DO NOT EDIT.
+package repository
+
+import (
+	"context"
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/repository"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+type APIKeyRepositoryProxy struct {
+	APIKeyRepository repository.APIKeyRepository
+}
+
+// newAPIKeyRepositoryProxy creates a new proxy with OpenTelemetry instrumentation
+func newAPIKeyRepositoryProxy(base repository.APIKeyRepository) repository.APIKeyRepository {
+	return &APIKeyRepositoryProxy{
+		APIKeyRepository: base,
+	}
+}
+
+// ProvideAPIKeyRepositoryProxy returns a function that decorates the original implementation with OpenTelemetry instrumentation
+func ProvideAPIKeyRepositoryProxy(enableTracing bool, base repository.APIKeyRepository) repository.APIKeyRepository {
+	if !enableTracing {
+		return base
+	}
+	
+	return newAPIKeyRepositoryProxy(base)
+}
+
+func (p *APIKeyRepositoryProxy) Create(ctx context.Context, key *entity.APIKey) (error) {
+	tracer := otel.Tracer("api-key-repo-tracer")
+	ctx, span := tracer.Start(ctx, "Create")
+	defer span.End()
+
+	err := p.APIKeyRepository.Create(ctx, key)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *APIKeyRepositoryProxy) FindByPrefix(ctx context.Context, prefix string) (*entity.APIKey, error) {
+	tracer := otel.Tracer("api-key-repo-tracer")
+	ctx, span := tracer.Start(ctx, "FindByPrefix")
+	defer span.End()
+
+	ret0, err := p.APIKeyRepository.FindByPrefix(ctx, prefix)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *APIKeyRepositoryProxy) ListByUserID(ctx context.Context, userID string) ([]*entity.APIKey, error) {
+	tracer := otel.Tracer("api-key-repo-tracer")
+	ctx, span := tracer.Start(ctx, "ListByUserID")
+	defer span.End()
+
+	ret0, err := p.APIKeyRepository.ListByUserID(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *APIKeyRepositoryProxy) Revoke(ctx context.Context, userID string, id string) (bool, error) {
+	tracer := otel.Tracer("api-key-repo-tracer")
+	ctx, span := tracer.Start(ctx, "Revoke")
+	defer span.End()
+
+	ret0, err := p.APIKeyRepository.Revoke(ctx, userID, id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *APIKeyRepositoryProxy) TouchLastUsed(ctx context.Context, id string, usedAt time.Time) (error) {
+	tracer := otel.Tracer("api-key-repo-tracer")
+	ctx, span := tracer.Start(ctx, "TouchLastUsed")
+	defer span.End()
+
+	err := p.APIKeyRepository.TouchLastUsed(ctx, id, usedAt)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
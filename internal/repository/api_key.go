@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/repository"
+	"server-template/internal/repository/gen/query"
+
+	"gorm.io/gorm"
+)
+
+type apiKeyRepository struct {
+	q *query.Query
+}
+
+func NewAPIKeyRepository(db *gorm.DB) repository.APIKeyRepository {
+	return &apiKeyRepository{q: query.Use(db)}
+}
+
+func (r *apiKeyRepository) Create(ctx context.Context, key *entity.APIKey) error {
+	return WrapNoValue(r.q.APIKey.WithContext(ctx).Create(key), "Create")
+}
+
+func (r *apiKeyRepository) FindByPrefix(ctx context.Context, prefix string) (*entity.APIKey, error) {
+	key, err := r.q.APIKey.WithContext(ctx).Where(r.q.APIKey.Prefix.Eq(prefix)).First()
+
+	return WrapResult(key, err, "FindByPrefix")
+}
+
+func (r *apiKeyRepository) ListByUserID(ctx context.Context, userID string) ([]*entity.APIKey, error) {
+	apiKey := r.q.APIKey
+	keys, err := apiKey.WithContext(ctx).
+		Where(apiKey.UserID.Eq(userID), apiKey.RevokedAt.IsNull()).
+		Order(apiKey.CreatedAt.Desc()).
+		Find()
+
+	return WrapResult(keys, err, "ListByUserID")
+}
+
+func (r *apiKeyRepository) Revoke(ctx context.Context, userID, id string) (bool, error) {
+	apiKey := r.q.APIKey
+	result, err := apiKey.WithContext(ctx).
+		Where(apiKey.ID.Eq(id), apiKey.UserID.Eq(userID), apiKey.RevokedAt.IsNull()).
+		Update(apiKey.RevokedAt, time.Now())
+	if err != nil {
+		return WrapResult(false, err, "Revoke")
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (r *apiKeyRepository) TouchLastUsed(ctx context.Context, id string, usedAt time.Time) error {
+	_, err := r.q.APIKey.WithContext(ctx).Where(r.q.APIKey.ID.Eq(id)).Update(r.q.APIKey.LastUsedAt, usedAt)
+
+	return WrapNoValue(err, "TouchLastUsed")
+}
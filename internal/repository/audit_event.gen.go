@@ -0,0 +1,73 @@
+// Code generated by proxy-generator. DO NOT EDIT.
+package repository
+
+import (
+	"context"
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/repository"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+type AuditEventRepositoryProxy struct {
+	AuditEventRepository repository.AuditEventRepository
+}
+
+// newAuditEventRepositoryProxy creates a new proxy with OpenTelemetry instrumentation
+func newAuditEventRepositoryProxy(base repository.AuditEventRepository) repository.AuditEventRepository {
+	return &AuditEventRepositoryProxy{
+		AuditEventRepository: base,
+	}
+}
+
+// ProvideAuditEventRepositoryProxy returns a function that decorates the original implementation with OpenTelemetry instrumentation
+func ProvideAuditEventRepositoryProxy(enableTracing bool, base repository.AuditEventRepository) repository.AuditEventRepository {
+	if !enableTracing {
+		return base
+	}
+	
+	return newAuditEventRepositoryProxy(base)
+}
+
+func (p *AuditEventRepositoryProxy) Create(ctx context.Context, event *entity.AuditEvent) (error) {
+	tracer := otel.Tracer("audit-event-repo-tracer")
+	ctx, span := tracer.Start(ctx, "Create")
+	defer span.End()
+
+	err := p.AuditEventRepository.Create(ctx, event)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *AuditEventRepositoryProxy) List(ctx context.Context, filter entity.AuditEventFilter, cursor *entity.Cursor, limit int) ([]*entity.AuditEvent, error) {
+	tracer := otel.Tracer("audit-event-repo-tracer")
+	ctx, span := tracer.Start(ctx, "List")
+	defer span.End()
+
+	ret0, err := p.AuditEventRepository.List(ctx, filter, cursor, limit)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AuditEventRepositoryProxy) ListByUserID(ctx context.Context, userID string) ([]*entity.AuditEvent, error) {
+	tracer := otel.Tracer("audit-event-repo-tracer")
+	ctx, span := tracer.Start(ctx, "ListByUserID")
+	defer span.End()
+
+	ret0, err := p.AuditEventRepository.ListByUserID(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/repository"
+	"server-template/internal/repository/gen/query"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
+)
+
+type auditEventRepository struct {
+	q *query.Query
+}
+
+func NewAuditEventRepository(db *gorm.DB) repository.AuditEventRepository {
+	return &auditEventRepository{q: query.Use(db)}
+}
+
+func (r *auditEventRepository) Create(ctx context.Context, event *entity.AuditEvent) error {
+	return WrapNoValue(r.q.AuditEvent.WithContext(ctx).Create(event), "Create")
+}
+
+func (r *auditEventRepository) List(ctx context.Context, filter entity.AuditEventFilter, cursor *entity.Cursor, limit int) ([]*entity.AuditEvent, error) {
+	auditEvent := r.q.AuditEvent
+	conds := r.filterConds(filter)
+	if cursor != nil {
+		// keyset 分頁：取排序在游標之後的資料，避免 OFFSET 隨頁數變慢
+		conds = append(conds, field.Or(
+			auditEvent.CreatedAt.Lt(cursor.CreatedAt),
+			field.And(auditEvent.CreatedAt.Eq(cursor.CreatedAt), auditEvent.ID.Lt(cursor.ID)),
+		))
+	}
+
+	events, err := auditEvent.WithContext(ctx).
+		Where(conds...).
+		Order(auditEvent.CreatedAt.Desc(), auditEvent.ID.Desc()).
+		Limit(limit).
+		Find()
+
+	return WrapResult(events, err, "List")
+}
+
+func (r *auditEventRepository) ListByUserID(ctx context.Context, userID string) ([]*entity.AuditEvent, error) {
+	auditEvent := r.q.AuditEvent
+	events, err := auditEvent.WithContext(ctx).
+		Where(r.filterConds(entity.AuditEventFilter{UserID: userID})...).
+		Order(auditEvent.CreatedAt.Desc(), auditEvent.ID.Desc()).
+		Find()
+
+	return WrapResult(events, err, "ListByUserID")
+}
+
+// filterConds 將篩選條件轉換為查詢條件
+func (r *auditEventRepository) filterConds(filter entity.AuditEventFilter) []gen.Condition {
+	auditEvent := r.q.AuditEvent
+
+	var conds []gen.Condition
+	if filter.UserID != "" {
+		conds = append(conds, field.Or(auditEvent.ActorID.Eq(filter.UserID), auditEvent.SubjectID.Eq(filter.UserID)))
+	}
+	if filter.CreatedAfter != nil {
+		conds = append(conds, auditEvent.CreatedAt.Gte(*filter.CreatedAfter))
+	}
+	if filter.CreatedBefore != nil {
+		conds = append(conds, auditEvent.CreatedAt.Lt(*filter.CreatedBefore))
+	}
+
+	return conds
+}
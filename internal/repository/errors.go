@@ -1,9 +1,29 @@
 package repository
 
 import (
+	"database/sql/driver"
 	"fmt"
+	"io"
+	"net"
+	"strings"
 
+	"server-template/internal/domain/errs"
+
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+const (
+	// pgUniqueViolation 為 PostgreSQL 違反唯一約束的 SQLSTATE
+	pgUniqueViolation = "23505"
+	// pgConnectionException 為 PostgreSQL 連線異常的 SQLSTATE 類別
+	pgConnectionException = "08"
+	// pgInsufficientResources 為 PostgreSQL 資源不足的 SQLSTATE 類別
+	pgInsufficientResources = "53"
+	// pgAdminShutdown 為 PostgreSQL 正在關閉的 SQLSTATE
+	pgAdminShutdown = "57P01"
 )
 
 // Wrap wraps an error with a descriptive message and the operation name
@@ -12,7 +32,7 @@ func Wrap[T any](result T, err error, operation string) (T, error) {
 		return result, nil
 	}
 
-	return result, errors.Wrapf(err, "repository.%s failed", operation)
+	return result, errors.Wrapf(classify(err), "repository.%s failed", operation)
 }
 
 // WrapNoValue wraps an error with a descriptive message and the operation name for operations that don't return a value
@@ -21,7 +41,7 @@ func WrapNoValue(err error, operation string) error {
 		return nil
 	}
 
-	return errors.Wrapf(err, "repository.%s failed", operation)
+	return errors.Wrapf(classify(err), "repository.%s failed", operation)
 }
 
 // WrapWithValue wraps an error with a descriptive message, operation name, and additional context
@@ -30,7 +50,7 @@ func WrapWithValue[T any](result T, err error, operation string, format string,
 		return result, nil
 	}
 
-	return result, errors.Wrapf(err, fmt.Sprintf("repository.%s failed: %s", operation, format), args...)
+	return result, errors.Wrapf(classify(err), fmt.Sprintf("repository.%s failed: %s", operation, format), args...)
 }
 
 // WrapResult wraps a result and error with a descriptive message and the operation name
@@ -39,5 +59,64 @@ func WrapResult[T any](result T, err error, operation string) (T, error) {
 		return result, nil
 	}
 
-	return result, errors.Wrapf(err, "repository.%s failed", operation)
+	return result, errors.Wrapf(classify(err), "repository.%s failed", operation)
+}
+
+// classify 將資料庫與 Redis 的錯誤歸類為領域錯誤，原始錯誤仍可透過 errors.Is/As 取得
+func classify(err error) error {
+	if _, ok := errs.As(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound), errors.Is(err, redis.Nil):
+		return errs.Wrap(err, errs.KindNotFound, "record not found")
+	case isDuplicateKey(err):
+		return errs.Wrap(err, errs.KindAlreadyExists, "record already exists")
+	case isUnavailable(err):
+		return errs.Wrap(err, errs.KindUnavailable, "storage is temporarily unavailable")
+	default:
+		return err
+	}
+}
+
+func isDuplicateKey(err error) bool {
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return true
+	}
+
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation
+}
+
+func isUnavailable(err error) bool {
+	if errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, redis.ErrClosed) ||
+		errors.Is(err, redis.ErrPoolTimeout) ||
+		errors.Is(err, redis.ErrPoolExhausted) {
+		return true
+	}
+
+	if redis.IsClusterDownError(err) || redis.IsLoadingError(err) ||
+		redis.IsTryAgainError(err) || redis.IsMasterDownError(err) {
+		return true
+	}
+
+	var connectErr *pgconn.ConnectError
+	if errors.As(err, &connectErr) {
+		return true
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == pgAdminShutdown ||
+			strings.HasPrefix(pgErr.Code, pgConnectionException) ||
+			strings.HasPrefix(pgErr.Code, pgInsufficientResources)
+	}
+
+	var netErr net.Error
+
+	return errors.As(err, &netErr)
 }
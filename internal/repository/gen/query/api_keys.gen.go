@@ -0,0 +1,363 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"server-template/internal/domain/entity"
+)
+
+func newAPIKey(db *gorm.DB, opts ...gen.DOOption) aPIKey {
+	_aPIKey := aPIKey{}
+
+	_aPIKey.aPIKeyDo.UseDB(db, opts...)
+	_aPIKey.aPIKeyDo.UseModel(&entity.APIKey{})
+
+	tableName := _aPIKey.aPIKeyDo.TableName()
+	_aPIKey.ALL = field.NewAsterisk(tableName)
+	_aPIKey.ID = field.NewString(tableName, "id")
+	_aPIKey.UserID = field.NewString(tableName, "user_id")
+	_aPIKey.Name = field.NewString(tableName, "name")
+	_aPIKey.Prefix = field.NewString(tableName, "prefix")
+	_aPIKey.SecretHash = field.NewString(tableName, "secret_hash")
+	_aPIKey.Scopes = field.NewString(tableName, "scopes")
+	_aPIKey.ExpiresAt = field.NewTime(tableName, "expires_at")
+	_aPIKey.LastUsedAt = field.NewTime(tableName, "last_used_at")
+	_aPIKey.RevokedAt = field.NewTime(tableName, "revoked_at")
+	_aPIKey.CreatedAt = field.NewTime(tableName, "created_at")
+
+	_aPIKey.fillFieldMap()
+
+	return _aPIKey
+}
+
+type aPIKey struct {
+	aPIKeyDo aPIKeyDo
+
+	ALL        field.Asterisk
+	ID         field.String
+	UserID     field.String
+	Name       field.String
+	Prefix     field.String
+	SecretHash field.String
+	Scopes     field.String
+	ExpiresAt  field.Time
+	LastUsedAt field.Time
+	RevokedAt  field.Time
+	CreatedAt  field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (a aPIKey) Table(newTableName string) *aPIKey {
+	a.aPIKeyDo.UseTable(newTableName)
+	return a.updateTableName(newTableName)
+}
+
+func (a aPIKey) As(alias string) *aPIKey {
+	a.aPIKeyDo.DO = *(a.aPIKeyDo.As(alias).(*gen.DO))
+	return a.updateTableName(alias)
+}
+
+func (a *aPIKey) updateTableName(table string) *aPIKey {
+	a.ALL = field.NewAsterisk(table)
+	a.ID = field.NewString(table, "id")
+	a.UserID = field.NewString(table, "user_id")
+	a.Name = field.NewString(table, "name")
+	a.Prefix = field.NewString(table, "prefix")
+	a.SecretHash = field.NewString(table, "secret_hash")
+	a.Scopes = field.NewString(table, "scopes")
+	a.ExpiresAt = field.NewTime(table, "expires_at")
+	a.LastUsedAt = field.NewTime(table, "last_used_at")
+	a.RevokedAt = field.NewTime(table, "revoked_at")
+	a.CreatedAt = field.NewTime(table, "created_at")
+
+	a.fillFieldMap()
+
+	return a
+}
+
+func (a *aPIKey) WithContext(ctx context.Context) *aPIKeyDo { return a.aPIKeyDo.WithContext(ctx) }
+
+func (a aPIKey) TableName() string { return a.aPIKeyDo.TableName() }
+
+func (a aPIKey) Alias() string { return a.aPIKeyDo.Alias() }
+
+func (a aPIKey) Columns(cols ...field.Expr) gen.Columns { return a.aPIKeyDo.Columns(cols...) }
+
+func (a *aPIKey) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := a.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (a *aPIKey) fillFieldMap() {
+	a.fieldMap = make(map[string]field.Expr, 10)
+	a.fieldMap["id"] = a.ID
+	a.fieldMap["user_id"] = a.UserID
+	a.fieldMap["name"] = a.Name
+	a.fieldMap["prefix"] = a.Prefix
+	a.fieldMap["secret_hash"] = a.SecretHash
+	a.fieldMap["scopes"] = a.Scopes
+	a.fieldMap["expires_at"] = a.ExpiresAt
+	a.fieldMap["last_used_at"] = a.LastUsedAt
+	a.fieldMap["revoked_at"] = a.RevokedAt
+	a.fieldMap["created_at"] = a.CreatedAt
+}
+
+func (a aPIKey) clone(db *gorm.DB) aPIKey {
+	a.aPIKeyDo.ReplaceConnPool(db.Statement.ConnPool)
+	return a
+}
+
+func (a aPIKey) replaceDB(db *gorm.DB) aPIKey {
+	a.aPIKeyDo.ReplaceDB(db)
+	return a
+}
+
+type aPIKeyDo struct{ gen.DO }
+
+func (a aPIKeyDo) Debug() *aPIKeyDo {
+	return a.withDO(a.DO.Debug())
+}
+
+func (a aPIKeyDo) WithContext(ctx context.Context) *aPIKeyDo {
+	return a.withDO(a.DO.WithContext(ctx))
+}
+
+func (a aPIKeyDo) ReadDB() *aPIKeyDo {
+	return a.Clauses(dbresolver.Read)
+}
+
+func (a aPIKeyDo) WriteDB() *aPIKeyDo {
+	return a.Clauses(dbresolver.Write)
+}
+
+func (a aPIKeyDo) Session(config *gorm.Session) *aPIKeyDo {
+	return a.withDO(a.DO.Session(config))
+}
+
+func (a aPIKeyDo) Clauses(conds ...clause.Expression) *aPIKeyDo {
+	return a.withDO(a.DO.Clauses(conds...))
+}
+
+func (a aPIKeyDo) Returning(value interface{}, columns ...string) *aPIKeyDo {
+	return a.withDO(a.DO.Returning(value, columns...))
+}
+
+func (a aPIKeyDo) Not(conds ...gen.Condition) *aPIKeyDo {
+	return a.withDO(a.DO.Not(conds...))
+}
+
+func (a aPIKeyDo) Or(conds ...gen.Condition) *aPIKeyDo {
+	return a.withDO(a.DO.Or(conds...))
+}
+
+func (a aPIKeyDo) Select(conds ...field.Expr) *aPIKeyDo {
+	return a.withDO(a.DO.Select(conds...))
+}
+
+func (a aPIKeyDo) Where(conds ...gen.Condition) *aPIKeyDo {
+	return a.withDO(a.DO.Where(conds...))
+}
+
+func (a aPIKeyDo) Order(conds ...field.Expr) *aPIKeyDo {
+	return a.withDO(a.DO.Order(conds...))
+}
+
+func (a aPIKeyDo) Distinct(cols ...field.Expr) *aPIKeyDo {
+	return a.withDO(a.DO.Distinct(cols...))
+}
+
+func (a aPIKeyDo) Omit(cols ...field.Expr) *aPIKeyDo {
+	return a.withDO(a.DO.Omit(cols...))
+}
+
+func (a aPIKeyDo) Join(table schema.Tabler, on ...field.Expr) *aPIKeyDo {
+	return a.withDO(a.DO.Join(table, on...))
+}
+
+func (a aPIKeyDo) LeftJoin(table schema.Tabler, on ...field.Expr) *aPIKeyDo {
+	return a.withDO(a.DO.LeftJoin(table, on...))
+}
+
+func (a aPIKeyDo) RightJoin(table schema.Tabler, on ...field.Expr) *aPIKeyDo {
+	return a.withDO(a.DO.RightJoin(table, on...))
+}
+
+func (a aPIKeyDo) Group(cols ...field.Expr) *aPIKeyDo {
+	return a.withDO(a.DO.Group(cols...))
+}
+
+func (a aPIKeyDo) Having(conds ...gen.Condition) *aPIKeyDo {
+	return a.withDO(a.DO.Having(conds...))
+}
+
+func (a aPIKeyDo) Limit(limit int) *aPIKeyDo {
+	return a.withDO(a.DO.Limit(limit))
+}
+
+func (a aPIKeyDo) Offset(offset int) *aPIKeyDo {
+	return a.withDO(a.DO.Offset(offset))
+}
+
+func (a aPIKeyDo) Scopes(funcs ...func(gen.Dao) gen.Dao) *aPIKeyDo {
+	return a.withDO(a.DO.Scopes(funcs...))
+}
+
+func (a aPIKeyDo) Unscoped() *aPIKeyDo {
+	return a.withDO(a.DO.Unscoped())
+}
+
+func (a aPIKeyDo) Create(values ...*entity.APIKey) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return a.DO.Create(values)
+}
+
+func (a aPIKeyDo) CreateInBatches(values []*entity.APIKey, batchSize int) error {
+	return a.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (a aPIKeyDo) Save(values ...*entity.APIKey) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return a.DO.Save(values)
+}
+
+func (a aPIKeyDo) First() (*entity.APIKey, error) {
+	if result, err := a.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*entity.APIKey), nil
+	}
+}
+
+func (a aPIKeyDo) Take() (*entity.APIKey, error) {
+	if result, err := a.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*entity.APIKey), nil
+	}
+}
+
+func (a aPIKeyDo) Last() (*entity.APIKey, error) {
+	if result, err := a.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*entity.APIKey), nil
+	}
+}
+
+func (a aPIKeyDo) Find() ([]*entity.APIKey, error) {
+	result, err := a.DO.Find()
+	return result.([]*entity.APIKey), err
+}
+
+func (a aPIKeyDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*entity.APIKey, err error) {
+	buf := make([]*entity.APIKey, 0, batchSize)
+	err = a.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (a aPIKeyDo) FindInBatches(result *[]*entity.APIKey, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return a.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (a aPIKeyDo) Attrs(attrs ...field.AssignExpr) *aPIKeyDo {
+	return a.withDO(a.DO.Attrs(attrs...))
+}
+
+func (a aPIKeyDo) Assign(attrs ...field.AssignExpr) *aPIKeyDo {
+	return a.withDO(a.DO.Assign(attrs...))
+}
+
+func (a aPIKeyDo) Joins(fields ...field.RelationField) *aPIKeyDo {
+	for _, _f := range fields {
+		a = *a.withDO(a.DO.Joins(_f))
+	}
+	return &a
+}
+
+func (a aPIKeyDo) Preload(fields ...field.RelationField) *aPIKeyDo {
+	for _, _f := range fields {
+		a = *a.withDO(a.DO.Preload(_f))
+	}
+	return &a
+}
+
+func (a aPIKeyDo) FirstOrInit() (*entity.APIKey, error) {
+	if result, err := a.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*entity.APIKey), nil
+	}
+}
+
+func (a aPIKeyDo) FirstOrCreate() (*entity.APIKey, error) {
+	if result, err := a.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*entity.APIKey), nil
+	}
+}
+
+func (a aPIKeyDo) FindByPage(offset int, limit int) (result []*entity.APIKey, count int64, err error) {
+	result, err = a.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = a.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (a aPIKeyDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = a.Count()
+	if err != nil {
+		return
+	}
+
+	err = a.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (a aPIKeyDo) Scan(result interface{}) (err error) {
+	return a.DO.Scan(result)
+}
+
+func (a aPIKeyDo) Delete(models ...*entity.APIKey) (result gen.ResultInfo, err error) {
+	return a.DO.Delete(models)
+}
+
+func (a *aPIKeyDo) withDO(do gen.Dao) *aPIKeyDo {
+	a.DO = *do.(*gen.DO)
+	return a
+}
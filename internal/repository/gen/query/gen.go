@@ -17,23 +17,53 @@ import (
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:   db,
-		User: newUser(db, opts...),
+		db:              db,
+		APIKey:          newAPIKey(db, opts...),
+		AuditEvent:      newAuditEvent(db, opts...),
+		MFARecoveryCode: newMFARecoveryCode(db, opts...),
+		MFASetting:      newMFASetting(db, opts...),
+		OAuthClient:     newOAuthClient(db, opts...),
+		Permission:      newPermission(db, opts...),
+		Role:            newRole(db, opts...),
+		RolePermission:  newRolePermission(db, opts...),
+		User:            newUser(db, opts...),
+		UserIdentity:    newUserIdentity(db, opts...),
+		UserRole:        newUserRole(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	User user
+	APIKey          aPIKey
+	AuditEvent      auditEvent
+	MFARecoveryCode mFARecoveryCode
+	MFASetting      mFASetting
+	OAuthClient     oAuthClient
+	Permission      permission
+	Role            role
+	RolePermission  rolePermission
+	User            user
+	UserIdentity    userIdentity
+	UserRole        userRole
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:   db,
-		User: q.User.clone(db),
+		db:              db,
+		APIKey:          q.APIKey.clone(db),
+		AuditEvent:      q.AuditEvent.clone(db),
+		MFARecoveryCode: q.MFARecoveryCode.clone(db),
+		MFASetting:      q.MFASetting.clone(db),
+		OAuthClient:     q.OAuthClient.clone(db),
+		Permission:      q.Permission.clone(db),
+		Role:            q.Role.clone(db),
+		RolePermission:  q.RolePermission.clone(db),
+		User:            q.User.clone(db),
+		UserIdentity:    q.UserIdentity.clone(db),
+		UserRole:        q.UserRole.clone(db),
 	}
 }
 
@@ -47,18 +77,48 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:   db,
-		User: q.User.replaceDB(db),
+		db:              db,
+		APIKey:          q.APIKey.replaceDB(db),
+		AuditEvent:      q.AuditEvent.replaceDB(db),
+		MFARecoveryCode: q.MFARecoveryCode.replaceDB(db),
+		MFASetting:      q.MFASetting.replaceDB(db),
+		OAuthClient:     q.OAuthClient.replaceDB(db),
+		Permission:      q.Permission.replaceDB(db),
+		Role:            q.Role.replaceDB(db),
+		RolePermission:  q.RolePermission.replaceDB(db),
+		User:            q.User.replaceDB(db),
+		UserIdentity:    q.UserIdentity.replaceDB(db),
+		UserRole:        q.UserRole.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	User *userDo
+	APIKey          *aPIKeyDo
+	AuditEvent      *auditEventDo
+	MFARecoveryCode *mFARecoveryCodeDo
+	MFASetting      *mFASettingDo
+	OAuthClient     *oAuthClientDo
+	Permission      *permissionDo
+	Role            *roleDo
+	RolePermission  *rolePermissionDo
+	User            *userDo
+	UserIdentity    *userIdentityDo
+	UserRole        *userRoleDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		User: q.User.WithContext(ctx),
+		APIKey:          q.APIKey.WithContext(ctx),
+		AuditEvent:      q.AuditEvent.WithContext(ctx),
+		MFARecoveryCode: q.MFARecoveryCode.WithContext(ctx),
+		MFASetting:      q.MFASetting.WithContext(ctx),
+		OAuthClient:     q.OAuthClient.WithContext(ctx),
+		Permission:      q.Permission.WithContext(ctx),
+		Role:            q.Role.WithContext(ctx),
+		RolePermission:  q.RolePermission.WithContext(ctx),
+		User:            q.User.WithContext(ctx),
+		UserIdentity:    q.UserIdentity.WithContext(ctx),
+		UserRole:        q.UserRole.WithContext(ctx),
 	}
 }
 
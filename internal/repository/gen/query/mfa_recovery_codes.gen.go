@@ -0,0 +1,347 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"server-template/internal/domain/entity"
+)
+
+func newMFARecoveryCode(db *gorm.DB, opts ...gen.DOOption) mFARecoveryCode {
+	_mFARecoveryCode := mFARecoveryCode{}
+
+	_mFARecoveryCode.mFARecoveryCodeDo.UseDB(db, opts...)
+	_mFARecoveryCode.mFARecoveryCodeDo.UseModel(&entity.MFARecoveryCode{})
+
+	tableName := _mFARecoveryCode.mFARecoveryCodeDo.TableName()
+	_mFARecoveryCode.ALL = field.NewAsterisk(tableName)
+	_mFARecoveryCode.ID = field.NewString(tableName, "id")
+	_mFARecoveryCode.UserID = field.NewString(tableName, "user_id")
+	_mFARecoveryCode.CodeHash = field.NewString(tableName, "code_hash")
+	_mFARecoveryCode.UsedAt = field.NewTime(tableName, "used_at")
+	_mFARecoveryCode.CreatedAt = field.NewTime(tableName, "created_at")
+
+	_mFARecoveryCode.fillFieldMap()
+
+	return _mFARecoveryCode
+}
+
+type mFARecoveryCode struct {
+	mFARecoveryCodeDo mFARecoveryCodeDo
+
+	ALL       field.Asterisk
+	ID        field.String
+	UserID    field.String
+	CodeHash  field.String
+	UsedAt    field.Time
+	CreatedAt field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (m mFARecoveryCode) Table(newTableName string) *mFARecoveryCode {
+	m.mFARecoveryCodeDo.UseTable(newTableName)
+	return m.updateTableName(newTableName)
+}
+
+func (m mFARecoveryCode) As(alias string) *mFARecoveryCode {
+	m.mFARecoveryCodeDo.DO = *(m.mFARecoveryCodeDo.As(alias).(*gen.DO))
+	return m.updateTableName(alias)
+}
+
+func (m *mFARecoveryCode) updateTableName(table string) *mFARecoveryCode {
+	m.ALL = field.NewAsterisk(table)
+	m.ID = field.NewString(table, "id")
+	m.UserID = field.NewString(table, "user_id")
+	m.CodeHash = field.NewString(table, "code_hash")
+	m.UsedAt = field.NewTime(table, "used_at")
+	m.CreatedAt = field.NewTime(table, "created_at")
+
+	m.fillFieldMap()
+
+	return m
+}
+
+func (m *mFARecoveryCode) WithContext(ctx context.Context) *mFARecoveryCodeDo {
+	return m.mFARecoveryCodeDo.WithContext(ctx)
+}
+
+func (m mFARecoveryCode) TableName() string { return m.mFARecoveryCodeDo.TableName() }
+
+func (m mFARecoveryCode) Alias() string { return m.mFARecoveryCodeDo.Alias() }
+
+func (m mFARecoveryCode) Columns(cols ...field.Expr) gen.Columns {
+	return m.mFARecoveryCodeDo.Columns(cols...)
+}
+
+func (m *mFARecoveryCode) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := m.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (m *mFARecoveryCode) fillFieldMap() {
+	m.fieldMap = make(map[string]field.Expr, 5)
+	m.fieldMap["id"] = m.ID
+	m.fieldMap["user_id"] = m.UserID
+	m.fieldMap["code_hash"] = m.CodeHash
+	m.fieldMap["used_at"] = m.UsedAt
+	m.fieldMap["created_at"] = m.CreatedAt
+}
+
+func (m mFARecoveryCode) clone(db *gorm.DB) mFARecoveryCode {
+	m.mFARecoveryCodeDo.ReplaceConnPool(db.Statement.ConnPool)
+	return m
+}
+
+func (m mFARecoveryCode) replaceDB(db *gorm.DB) mFARecoveryCode {
+	m.mFARecoveryCodeDo.ReplaceDB(db)
+	return m
+}
+
+type mFARecoveryCodeDo struct{ gen.DO }
+
+func (m mFARecoveryCodeDo) Debug() *mFARecoveryCodeDo {
+	return m.withDO(m.DO.Debug())
+}
+
+func (m mFARecoveryCodeDo) WithContext(ctx context.Context) *mFARecoveryCodeDo {
+	return m.withDO(m.DO.WithContext(ctx))
+}
+
+func (m mFARecoveryCodeDo) ReadDB() *mFARecoveryCodeDo {
+	return m.Clauses(dbresolver.Read)
+}
+
+func (m mFARecoveryCodeDo) WriteDB() *mFARecoveryCodeDo {
+	return m.Clauses(dbresolver.Write)
+}
+
+func (m mFARecoveryCodeDo) Session(config *gorm.Session) *mFARecoveryCodeDo {
+	return m.withDO(m.DO.Session(config))
+}
+
+func (m mFARecoveryCodeDo) Clauses(conds ...clause.Expression) *mFARecoveryCodeDo {
+	return m.withDO(m.DO.Clauses(conds...))
+}
+
+func (m mFARecoveryCodeDo) Returning(value interface{}, columns ...string) *mFARecoveryCodeDo {
+	return m.withDO(m.DO.Returning(value, columns...))
+}
+
+func (m mFARecoveryCodeDo) Not(conds ...gen.Condition) *mFARecoveryCodeDo {
+	return m.withDO(m.DO.Not(conds...))
+}
+
+func (m mFARecoveryCodeDo) Or(conds ...gen.Condition) *mFARecoveryCodeDo {
+	return m.withDO(m.DO.Or(conds...))
+}
+
+func (m mFARecoveryCodeDo) Select(conds ...field.Expr) *mFARecoveryCodeDo {
+	return m.withDO(m.DO.Select(conds...))
+}
+
+func (m mFARecoveryCodeDo) Where(conds ...gen.Condition) *mFARecoveryCodeDo {
+	return m.withDO(m.DO.Where(conds...))
+}
+
+func (m mFARecoveryCodeDo) Order(conds ...field.Expr) *mFARecoveryCodeDo {
+	return m.withDO(m.DO.Order(conds...))
+}
+
+func (m mFARecoveryCodeDo) Distinct(cols ...field.Expr) *mFARecoveryCodeDo {
+	return m.withDO(m.DO.Distinct(cols...))
+}
+
+func (m mFARecoveryCodeDo) Omit(cols ...field.Expr) *mFARecoveryCodeDo {
+	return m.withDO(m.DO.Omit(cols...))
+}
+
+func (m mFARecoveryCodeDo) Join(table schema.Tabler, on ...field.Expr) *mFARecoveryCodeDo {
+	return m.withDO(m.DO.Join(table, on...))
+}
+
+func (m mFARecoveryCodeDo) LeftJoin(table schema.Tabler, on ...field.Expr) *mFARecoveryCodeDo {
+	return m.withDO(m.DO.LeftJoin(table, on...))
+}
+
+func (m mFARecoveryCodeDo) RightJoin(table schema.Tabler, on ...field.Expr) *mFARecoveryCodeDo {
+	return m.withDO(m.DO.RightJoin(table, on...))
+}
+
+func (m mFARecoveryCodeDo) Group(cols ...field.Expr) *mFARecoveryCodeDo {
+	return m.withDO(m.DO.Group(cols...))
+}
+
+func (m mFARecoveryCodeDo) Having(conds ...gen.Condition) *mFARecoveryCodeDo {
+	return m.withDO(m.DO.Having(conds...))
+}
+
+func (m mFARecoveryCodeDo) Limit(limit int) *mFARecoveryCodeDo {
+	return m.withDO(m.DO.Limit(limit))
+}
+
+func (m mFARecoveryCodeDo) Offset(offset int) *mFARecoveryCodeDo {
+	return m.withDO(m.DO.Offset(offset))
+}
+
+func (m mFARecoveryCodeDo) Scopes(funcs ...func(gen.Dao) gen.Dao) *mFARecoveryCodeDo {
+	return m.withDO(m.DO.Scopes(funcs...))
+}
+
+func (m mFARecoveryCodeDo) Unscoped() *mFARecoveryCodeDo {
+	return m.withDO(m.DO.Unscoped())
+}
+
+func (m mFARecoveryCodeDo) Create(values ...*entity.MFARecoveryCode) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return m.DO.Create(values)
+}
+
+func (m mFARecoveryCodeDo) CreateInBatches(values []*entity.MFARecoveryCode, batchSize int) error {
+	return m.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (m mFARecoveryCodeDo) Save(values ...*entity.MFARecoveryCode) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return m.DO.Save(values)
+}
+
+func (m mFARecoveryCodeDo) First() (*entity.MFARecoveryCode, error) {
+	if result, err := m.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*entity.MFARecoveryCode), nil
+	}
+}
+
+func (m mFARecoveryCodeDo) Take() (*entity.MFARecoveryCode, error) {
+	if result, err := m.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*entity.MFARecoveryCode), nil
+	}
+}
+
+func (m mFARecoveryCodeDo) Last() (*entity.MFARecoveryCode, error) {
+	if result, err := m.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*entity.MFARecoveryCode), nil
+	}
+}
+
+func (m mFARecoveryCodeDo) Find() ([]*entity.MFARecoveryCode, error) {
+	result, err := m.DO.Find()
+	return result.([]*entity.MFARecoveryCode), err
+}
+
+func (m mFARecoveryCodeDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*entity.MFARecoveryCode, err error) {
+	buf := make([]*entity.MFARecoveryCode, 0, batchSize)
+	err = m.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (m mFARecoveryCodeDo) FindInBatches(result *[]*entity.MFARecoveryCode, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return m.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (m mFARecoveryCodeDo) Attrs(attrs ...field.AssignExpr) *mFARecoveryCodeDo {
+	return m.withDO(m.DO.Attrs(attrs...))
+}
+
+func (m mFARecoveryCodeDo) Assign(attrs ...field.AssignExpr) *mFARecoveryCodeDo {
+	return m.withDO(m.DO.Assign(attrs...))
+}
+
+func (m mFARecoveryCodeDo) Joins(fields ...field.RelationField) *mFARecoveryCodeDo {
+	for _, _f := range fields {
+		m = *m.withDO(m.DO.Joins(_f))
+	}
+	return &m
+}
+
+func (m mFARecoveryCodeDo) Preload(fields ...field.RelationField) *mFARecoveryCodeDo {
+	for _, _f := range fields {
+		m = *m.withDO(m.DO.Preload(_f))
+	}
+	return &m
+}
+
+func (m mFARecoveryCodeDo) FirstOrInit() (*entity.MFARecoveryCode, error) {
+	if result, err := m.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*entity.MFARecoveryCode), nil
+	}
+}
+
+func (m mFARecoveryCodeDo) FirstOrCreate() (*entity.MFARecoveryCode, error) {
+	if result, err := m.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*entity.MFARecoveryCode), nil
+	}
+}
+
+func (m mFARecoveryCodeDo) FindByPage(offset int, limit int) (result []*entity.MFARecoveryCode, count int64, err error) {
+	result, err = m.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = m.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (m mFARecoveryCodeDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = m.Count()
+	if err != nil {
+		return
+	}
+
+	err = m.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (m mFARecoveryCodeDo) Scan(result interface{}) (err error) {
+	return m.DO.Scan(result)
+}
+
+func (m mFARecoveryCodeDo) Delete(models ...*entity.MFARecoveryCode) (result gen.ResultInfo, err error) {
+	return m.DO.Delete(models)
+}
+
+func (m *mFARecoveryCodeDo) withDO(do gen.Dao) *mFARecoveryCodeDo {
+	m.DO = *do.(*gen.DO)
+	return m
+}
@@ -0,0 +1,349 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"server-template/internal/domain/entity"
+)
+
+func newMFASetting(db *gorm.DB, opts ...gen.DOOption) mFASetting {
+	_mFASetting := mFASetting{}
+
+	_mFASetting.mFASettingDo.UseDB(db, opts...)
+	_mFASetting.mFASettingDo.UseModel(&entity.MFASetting{})
+
+	tableName := _mFASetting.mFASettingDo.TableName()
+	_mFASetting.ALL = field.NewAsterisk(tableName)
+	_mFASetting.UserID = field.NewString(tableName, "user_id")
+	_mFASetting.Secret = field.NewString(tableName, "secret")
+	_mFASetting.Enabled = field.NewBool(tableName, "enabled")
+	_mFASetting.EnabledAt = field.NewTime(tableName, "enabled_at")
+	_mFASetting.CreatedAt = field.NewTime(tableName, "created_at")
+	_mFASetting.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_mFASetting.fillFieldMap()
+
+	return _mFASetting
+}
+
+type mFASetting struct {
+	mFASettingDo mFASettingDo
+
+	ALL       field.Asterisk
+	UserID    field.String
+	Secret    field.String
+	Enabled   field.Bool
+	EnabledAt field.Time
+	CreatedAt field.Time
+	UpdatedAt field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (m mFASetting) Table(newTableName string) *mFASetting {
+	m.mFASettingDo.UseTable(newTableName)
+	return m.updateTableName(newTableName)
+}
+
+func (m mFASetting) As(alias string) *mFASetting {
+	m.mFASettingDo.DO = *(m.mFASettingDo.As(alias).(*gen.DO))
+	return m.updateTableName(alias)
+}
+
+func (m *mFASetting) updateTableName(table string) *mFASetting {
+	m.ALL = field.NewAsterisk(table)
+	m.UserID = field.NewString(table, "user_id")
+	m.Secret = field.NewString(table, "secret")
+	m.Enabled = field.NewBool(table, "enabled")
+	m.EnabledAt = field.NewTime(table, "enabled_at")
+	m.CreatedAt = field.NewTime(table, "created_at")
+	m.UpdatedAt = field.NewTime(table, "updated_at")
+
+	m.fillFieldMap()
+
+	return m
+}
+
+func (m *mFASetting) WithContext(ctx context.Context) *mFASettingDo {
+	return m.mFASettingDo.WithContext(ctx)
+}
+
+func (m mFASetting) TableName() string { return m.mFASettingDo.TableName() }
+
+func (m mFASetting) Alias() string { return m.mFASettingDo.Alias() }
+
+func (m mFASetting) Columns(cols ...field.Expr) gen.Columns { return m.mFASettingDo.Columns(cols...) }
+
+func (m *mFASetting) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := m.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (m *mFASetting) fillFieldMap() {
+	m.fieldMap = make(map[string]field.Expr, 6)
+	m.fieldMap["user_id"] = m.UserID
+	m.fieldMap["secret"] = m.Secret
+	m.fieldMap["enabled"] = m.Enabled
+	m.fieldMap["enabled_at"] = m.EnabledAt
+	m.fieldMap["created_at"] = m.CreatedAt
+	m.fieldMap["updated_at"] = m.UpdatedAt
+}
+
+func (m mFASetting) clone(db *gorm.DB) mFASetting {
+	m.mFASettingDo.ReplaceConnPool(db.Statement.ConnPool)
+	return m
+}
+
+func (m mFASetting) replaceDB(db *gorm.DB) mFASetting {
+	m.mFASettingDo.ReplaceDB(db)
+	return m
+}
+
+type mFASettingDo struct{ gen.DO }
+
+func (m mFASettingDo) Debug() *mFASettingDo {
+	return m.withDO(m.DO.Debug())
+}
+
+func (m mFASettingDo) WithContext(ctx context.Context) *mFASettingDo {
+	return m.withDO(m.DO.WithContext(ctx))
+}
+
+func (m mFASettingDo) ReadDB() *mFASettingDo {
+	return m.Clauses(dbresolver.Read)
+}
+
+func (m mFASettingDo) WriteDB() *mFASettingDo {
+	return m.Clauses(dbresolver.Write)
+}
+
+func (m mFASettingDo) Session(config *gorm.Session) *mFASettingDo {
+	return m.withDO(m.DO.Session(config))
+}
+
+func (m mFASettingDo) Clauses(conds ...clause.Expression) *mFASettingDo {
+	return m.withDO(m.DO.Clauses(conds...))
+}
+
+func (m mFASettingDo) Returning(value interface{}, columns ...string) *mFASettingDo {
+	return m.withDO(m.DO.Returning(value, columns...))
+}
+
+func (m mFASettingDo) Not(conds ...gen.Condition) *mFASettingDo {
+	return m.withDO(m.DO.Not(conds...))
+}
+
+func (m mFASettingDo) Or(conds ...gen.Condition) *mFASettingDo {
+	return m.withDO(m.DO.Or(conds...))
+}
+
+func (m mFASettingDo) Select(conds ...field.Expr) *mFASettingDo {
+	return m.withDO(m.DO.Select(conds...))
+}
+
+func (m mFASettingDo) Where(conds ...gen.Condition) *mFASettingDo {
+	return m.withDO(m.DO.Where(conds...))
+}
+
+func (m mFASettingDo) Order(conds ...field.Expr) *mFASettingDo {
+	return m.withDO(m.DO.Order(conds...))
+}
+
+func (m mFASettingDo) Distinct(cols ...field.Expr) *mFASettingDo {
+	return m.withDO(m.DO.Distinct(cols...))
+}
+
+func (m mFASettingDo) Omit(cols ...field.Expr) *mFASettingDo {
+	return m.withDO(m.DO.Omit(cols...))
+}
+
+func (m mFASettingDo) Join(table schema.Tabler, on ...field.Expr) *mFASettingDo {
+	return m.withDO(m.DO.Join(table, on...))
+}
+
+func (m mFASettingDo) LeftJoin(table schema.Tabler, on ...field.Expr) *mFASettingDo {
+	return m.withDO(m.DO.LeftJoin(table, on...))
+}
+
+func (m mFASettingDo) RightJoin(table schema.Tabler, on ...field.Expr) *mFASettingDo {
+	return m.withDO(m.DO.RightJoin(table, on...))
+}
+
+func (m mFASettingDo) Group(cols ...field.Expr) *mFASettingDo {
+	return m.withDO(m.DO.Group(cols...))
+}
+
+func (m mFASettingDo) Having(conds ...gen.Condition) *mFASettingDo {
+	return m.withDO(m.DO.Having(conds...))
+}
+
+func (m mFASettingDo) Limit(limit int) *mFASettingDo {
+	return m.withDO(m.DO.Limit(limit))
+}
+
+func (m mFASettingDo) Offset(offset int) *mFASettingDo {
+	return m.withDO(m.DO.Offset(offset))
+}
+
+func (m mFASettingDo) Scopes(funcs ...func(gen.Dao) gen.Dao) *mFASettingDo {
+	return m.withDO(m.DO.Scopes(funcs...))
+}
+
+func (m mFASettingDo) Unscoped() *mFASettingDo {
+	return m.withDO(m.DO.Unscoped())
+}
+
+func (m mFASettingDo) Create(values ...*entity.MFASetting) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return m.DO.Create(values)
+}
+
+func (m mFASettingDo) CreateInBatches(values []*entity.MFASetting, batchSize int) error {
+	return m.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (m mFASettingDo) Save(values ...*entity.MFASetting) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return m.DO.Save(values)
+}
+
+func (m mFASettingDo) First() (*entity.MFASetting, error) {
+	if result, err := m.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*entity.MFASetting), nil
+	}
+}
+
+func (m mFASettingDo) Take() (*entity.MFASetting, error) {
+	if result, err := m.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*entity.MFASetting), nil
+	}
+}
+
+func (m mFASettingDo) Last() (*entity.MFASetting, error) {
+	if result, err := m.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*entity.MFASetting), nil
+	}
+}
+
+func (m mFASettingDo) Find() ([]*entity.MFASetting, error) {
+	result, err := m.DO.Find()
+	return result.([]*entity.MFASetting), err
+}
+
+func (m mFASettingDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*entity.MFASetting, err error) {
+	buf := make([]*entity.MFASetting, 0, batchSize)
+	err = m.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (m mFASettingDo) FindInBatches(result *[]*entity.MFASetting, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return m.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (m mFASettingDo) Attrs(attrs ...field.AssignExpr) *mFASettingDo {
+	return m.withDO(m.DO.Attrs(attrs...))
+}
+
+func (m mFASettingDo) Assign(attrs ...field.AssignExpr) *mFASettingDo {
+	return m.withDO(m.DO.Assign(attrs...))
+}
+
+func (m mFASettingDo) Joins(fields ...field.RelationField) *mFASettingDo {
+	for _, _f := range fields {
+		m = *m.withDO(m.DO.Joins(_f))
+	}
+	return &m
+}
+
+func (m mFASettingDo) Preload(fields ...field.RelationField) *mFASettingDo {
+	for _, _f := range fields {
+		m = *m.withDO(m.DO.Preload(_f))
+	}
+	return &m
+}
+
+func (m mFASettingDo) FirstOrInit() (*entity.MFASetting, error) {
+	if result, err := m.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*entity.MFASetting), nil
+	}
+}
+
+func (m mFASettingDo) FirstOrCreate() (*entity.MFASetting, error) {
+	if result, err := m.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*entity.MFASetting), nil
+	}
+}
+
+func (m mFASettingDo) FindByPage(offset int, limit int) (result []*entity.MFASetting, count int64, err error) {
+	result, err = m.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = m.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (m mFASettingDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = m.Count()
+	if err != nil {
+		return
+	}
+
+	err = m.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (m mFASettingDo) Scan(result interface{}) (err error) {
+	return m.DO.Scan(result)
+}
+
+func (m mFASettingDo) Delete(models ...*entity.MFASetting) (result gen.ResultInfo, err error) {
+	return m.DO.Delete(models)
+}
+
+func (m *mFASettingDo) withDO(do gen.Dao) *mFASettingDo {
+	m.DO = *do.(*gen.DO)
+	return m
+}
@@ -0,0 +1,355 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"server-template/internal/domain/entity"
+)
+
+func newUserIdentity(db *gorm.DB, opts ...gen.DOOption) userIdentity {
+	_userIdentity := userIdentity{}
+
+	_userIdentity.userIdentityDo.UseDB(db, opts...)
+	_userIdentity.userIdentityDo.UseModel(&entity.UserIdentity{})
+
+	tableName := _userIdentity.userIdentityDo.TableName()
+	_userIdentity.ALL = field.NewAsterisk(tableName)
+	_userIdentity.ID = field.NewString(tableName, "id")
+	_userIdentity.UserID = field.NewString(tableName, "user_id")
+	_userIdentity.Provider = field.NewString(tableName, "provider")
+	_userIdentity.Subject = field.NewString(tableName, "subject")
+	_userIdentity.Email = field.NewString(tableName, "email")
+	_userIdentity.CreatedAt = field.NewTime(tableName, "created_at")
+	_userIdentity.LastLoginAt = field.NewTime(tableName, "last_login_at")
+
+	_userIdentity.fillFieldMap()
+
+	return _userIdentity
+}
+
+type userIdentity struct {
+	userIdentityDo userIdentityDo
+
+	ALL         field.Asterisk
+	ID          field.String
+	UserID      field.String
+	Provider    field.String
+	Subject     field.String
+	Email       field.String
+	CreatedAt   field.Time
+	LastLoginAt field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (u userIdentity) Table(newTableName string) *userIdentity {
+	u.userIdentityDo.UseTable(newTableName)
+	return u.updateTableName(newTableName)
+}
+
+func (u userIdentity) As(alias string) *userIdentity {
+	u.userIdentityDo.DO = *(u.userIdentityDo.As(alias).(*gen.DO))
+	return u.updateTableName(alias)
+}
+
+func (u *userIdentity) updateTableName(table string) *userIdentity {
+	u.ALL = field.NewAsterisk(table)
+	u.ID = field.NewString(table, "id")
+	u.UserID = field.NewString(table, "user_id")
+	u.Provider = field.NewString(table, "provider")
+	u.Subject = field.NewString(table, "subject")
+	u.Email = field.NewString(table, "email")
+	u.CreatedAt = field.NewTime(table, "created_at")
+	u.LastLoginAt = field.NewTime(table, "last_login_at")
+
+	u.fillFieldMap()
+
+	return u
+}
+
+func (u *userIdentity) WithContext(ctx context.Context) *userIdentityDo {
+	return u.userIdentityDo.WithContext(ctx)
+}
+
+func (u userIdentity) TableName() string { return u.userIdentityDo.TableName() }
+
+func (u userIdentity) Alias() string { return u.userIdentityDo.Alias() }
+
+func (u userIdentity) Columns(cols ...field.Expr) gen.Columns {
+	return u.userIdentityDo.Columns(cols...)
+}
+
+func (u *userIdentity) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := u.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (u *userIdentity) fillFieldMap() {
+	u.fieldMap = make(map[string]field.Expr, 7)
+	u.fieldMap["id"] = u.ID
+	u.fieldMap["user_id"] = u.UserID
+	u.fieldMap["provider"] = u.Provider
+	u.fieldMap["subject"] = u.Subject
+	u.fieldMap["email"] = u.Email
+	u.fieldMap["created_at"] = u.CreatedAt
+	u.fieldMap["last_login_at"] = u.LastLoginAt
+}
+
+func (u userIdentity) clone(db *gorm.DB) userIdentity {
+	u.userIdentityDo.ReplaceConnPool(db.Statement.ConnPool)
+	return u
+}
+
+func (u userIdentity) replaceDB(db *gorm.DB) userIdentity {
+	u.userIdentityDo.ReplaceDB(db)
+	return u
+}
+
+type userIdentityDo struct{ gen.DO }
+
+func (u userIdentityDo) Debug() *userIdentityDo {
+	return u.withDO(u.DO.Debug())
+}
+
+func (u userIdentityDo) WithContext(ctx context.Context) *userIdentityDo {
+	return u.withDO(u.DO.WithContext(ctx))
+}
+
+func (u userIdentityDo) ReadDB() *userIdentityDo {
+	return u.Clauses(dbresolver.Read)
+}
+
+func (u userIdentityDo) WriteDB() *userIdentityDo {
+	return u.Clauses(dbresolver.Write)
+}
+
+func (u userIdentityDo) Session(config *gorm.Session) *userIdentityDo {
+	return u.withDO(u.DO.Session(config))
+}
+
+func (u userIdentityDo) Clauses(conds ...clause.Expression) *userIdentityDo {
+	return u.withDO(u.DO.Clauses(conds...))
+}
+
+func (u userIdentityDo) Returning(value interface{}, columns ...string) *userIdentityDo {
+	return u.withDO(u.DO.Returning(value, columns...))
+}
+
+func (u userIdentityDo) Not(conds ...gen.Condition) *userIdentityDo {
+	return u.withDO(u.DO.Not(conds...))
+}
+
+func (u userIdentityDo) Or(conds ...gen.Condition) *userIdentityDo {
+	return u.withDO(u.DO.Or(conds...))
+}
+
+func (u userIdentityDo) Select(conds ...field.Expr) *userIdentityDo {
+	return u.withDO(u.DO.Select(conds...))
+}
+
+func (u userIdentityDo) Where(conds ...gen.Condition) *userIdentityDo {
+	return u.withDO(u.DO.Where(conds...))
+}
+
+func (u userIdentityDo) Order(conds ...field.Expr) *userIdentityDo {
+	return u.withDO(u.DO.Order(conds...))
+}
+
+func (u userIdentityDo) Distinct(cols ...field.Expr) *userIdentityDo {
+	return u.withDO(u.DO.Distinct(cols...))
+}
+
+func (u userIdentityDo) Omit(cols ...field.Expr) *userIdentityDo {
+	return u.withDO(u.DO.Omit(cols...))
+}
+
+func (u userIdentityDo) Join(table schema.Tabler, on ...field.Expr) *userIdentityDo {
+	return u.withDO(u.DO.Join(table, on...))
+}
+
+func (u userIdentityDo) LeftJoin(table schema.Tabler, on ...field.Expr) *userIdentityDo {
+	return u.withDO(u.DO.LeftJoin(table, on...))
+}
+
+func (u userIdentityDo) RightJoin(table schema.Tabler, on ...field.Expr) *userIdentityDo {
+	return u.withDO(u.DO.RightJoin(table, on...))
+}
+
+func (u userIdentityDo) Group(cols ...field.Expr) *userIdentityDo {
+	return u.withDO(u.DO.Group(cols...))
+}
+
+func (u userIdentityDo) Having(conds ...gen.Condition) *userIdentityDo {
+	return u.withDO(u.DO.Having(conds...))
+}
+
+func (u userIdentityDo) Limit(limit int) *userIdentityDo {
+	return u.withDO(u.DO.Limit(limit))
+}
+
+func (u userIdentityDo) Offset(offset int) *userIdentityDo {
+	return u.withDO(u.DO.Offset(offset))
+}
+
+func (u userIdentityDo) Scopes(funcs ...func(gen.Dao) gen.Dao) *userIdentityDo {
+	return u.withDO(u.DO.Scopes(funcs...))
+}
+
+func (u userIdentityDo) Unscoped() *userIdentityDo {
+	return u.withDO(u.DO.Unscoped())
+}
+
+func (u userIdentityDo) Create(values ...*entity.UserIdentity) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return u.DO.Create(values)
+}
+
+func (u userIdentityDo) CreateInBatches(values []*entity.UserIdentity, batchSize int) error {
+	return u.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (u userIdentityDo) Save(values ...*entity.UserIdentity) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return u.DO.Save(values)
+}
+
+func (u userIdentityDo) First() (*entity.UserIdentity, error) {
+	if result, err := u.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*entity.UserIdentity), nil
+	}
+}
+
+func (u userIdentityDo) Take() (*entity.UserIdentity, error) {
+	if result, err := u.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*entity.UserIdentity), nil
+	}
+}
+
+func (u userIdentityDo) Last() (*entity.UserIdentity, error) {
+	if result, err := u.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*entity.UserIdentity), nil
+	}
+}
+
+func (u userIdentityDo) Find() ([]*entity.UserIdentity, error) {
+	result, err := u.DO.Find()
+	return result.([]*entity.UserIdentity), err
+}
+
+func (u userIdentityDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*entity.UserIdentity, err error) {
+	buf := make([]*entity.UserIdentity, 0, batchSize)
+	err = u.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (u userIdentityDo) FindInBatches(result *[]*entity.UserIdentity, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return u.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (u userIdentityDo) Attrs(attrs ...field.AssignExpr) *userIdentityDo {
+	return u.withDO(u.DO.Attrs(attrs...))
+}
+
+func (u userIdentityDo) Assign(attrs ...field.AssignExpr) *userIdentityDo {
+	return u.withDO(u.DO.Assign(attrs...))
+}
+
+func (u userIdentityDo) Joins(fields ...field.RelationField) *userIdentityDo {
+	for _, _f := range fields {
+		u = *u.withDO(u.DO.Joins(_f))
+	}
+	return &u
+}
+
+func (u userIdentityDo) Preload(fields ...field.RelationField) *userIdentityDo {
+	for _, _f := range fields {
+		u = *u.withDO(u.DO.Preload(_f))
+	}
+	return &u
+}
+
+func (u userIdentityDo) FirstOrInit() (*entity.UserIdentity, error) {
+	if result, err := u.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*entity.UserIdentity), nil
+	}
+}
+
+func (u userIdentityDo) FirstOrCreate() (*entity.UserIdentity, error) {
+	if result, err := u.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*entity.UserIdentity), nil
+	}
+}
+
+func (u userIdentityDo) FindByPage(offset int, limit int) (result []*entity.UserIdentity, count int64, err error) {
+	result, err = u.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = u.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (u userIdentityDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = u.Count()
+	if err != nil {
+		return
+	}
+
+	err = u.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (u userIdentityDo) Scan(result interface{}) (err error) {
+	return u.DO.Scan(result)
+}
+
+func (u userIdentityDo) Delete(models ...*entity.UserIdentity) (result gen.ResultInfo, err error) {
+	return u.DO.Delete(models)
+}
+
+func (u *userIdentityDo) withDO(do gen.Dao) *userIdentityDo {
+	u.DO = *do.(*gen.DO)
+	return u
+}
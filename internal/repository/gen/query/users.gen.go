@@ -31,9 +31,15 @@ func newUser(db *gorm.DB, opts ...gen.DOOption) user {
 	_user.Name = field.NewString(tableName, "name")
 	_user.Email = field.NewString(tableName, "email")
 	_user.Password = field.NewString(tableName, "password")
+	_user.PendingEmail = field.NewString(tableName, "pending_email")
 	_user.Status = field.NewInt(tableName, "status")
 	_user.CreatedAt = field.NewTime(tableName, "created_at")
 	_user.UpdatedAt = field.NewTime(tableName, "updated_at")
+	_user.MFA = userHasOneMFA{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("MFA", "entity.MFASetting"),
+	}
 
 	_user.fillFieldMap()
 
@@ -43,14 +49,16 @@ func newUser(db *gorm.DB, opts ...gen.DOOption) user {
 type user struct {
 	userDo userDo
 
-	ALL       field.Asterisk
-	ID        field.String
-	Name      field.String
-	Email     field.String
-	Password  field.String
-	Status    field.Int
-	CreatedAt field.Time
-	UpdatedAt field.Time
+	ALL          field.Asterisk
+	ID           field.String
+	Name         field.String
+	Email        field.String
+	Password     field.String
+	PendingEmail field.String
+	Status       field.Int
+	CreatedAt    field.Time
+	UpdatedAt    field.Time
+	MFA          userHasOneMFA
 
 	fieldMap map[string]field.Expr
 }
@@ -71,6 +79,7 @@ func (u *user) updateTableName(table string) *user {
 	u.Name = field.NewString(table, "name")
 	u.Email = field.NewString(table, "email")
 	u.Password = field.NewString(table, "password")
+	u.PendingEmail = field.NewString(table, "pending_email")
 	u.Status = field.NewInt(table, "status")
 	u.CreatedAt = field.NewTime(table, "created_at")
 	u.UpdatedAt = field.NewTime(table, "updated_at")
@@ -98,26 +107,112 @@ func (u *user) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (u *user) fillFieldMap() {
-	u.fieldMap = make(map[string]field.Expr, 7)
+	u.fieldMap = make(map[string]field.Expr, 9)
 	u.fieldMap["id"] = u.ID
 	u.fieldMap["name"] = u.Name
 	u.fieldMap["email"] = u.Email
 	u.fieldMap["password"] = u.Password
+	u.fieldMap["pending_email"] = u.PendingEmail
 	u.fieldMap["status"] = u.Status
 	u.fieldMap["created_at"] = u.CreatedAt
 	u.fieldMap["updated_at"] = u.UpdatedAt
+
 }
 
 func (u user) clone(db *gorm.DB) user {
 	u.userDo.ReplaceConnPool(db.Statement.ConnPool)
+	u.MFA.db = db.Session(&gorm.Session{Initialized: true})
+	u.MFA.db.Statement.ConnPool = db.Statement.ConnPool
 	return u
 }
 
 func (u user) replaceDB(db *gorm.DB) user {
 	u.userDo.ReplaceDB(db)
+	u.MFA.db = db.Session(&gorm.Session{})
 	return u
 }
 
+type userHasOneMFA struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a userHasOneMFA) Where(conds ...field.Expr) *userHasOneMFA {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a userHasOneMFA) WithContext(ctx context.Context) *userHasOneMFA {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a userHasOneMFA) Session(session *gorm.Session) *userHasOneMFA {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a userHasOneMFA) Model(m *entity.User) *userHasOneMFATx {
+	return &userHasOneMFATx{a.db.Model(m).Association(a.Name())}
+}
+
+func (a userHasOneMFA) Unscoped() *userHasOneMFA {
+	a.db = a.db.Unscoped()
+	return &a
+}
+
+type userHasOneMFATx struct{ tx *gorm.Association }
+
+func (a userHasOneMFATx) Find() (result *entity.MFASetting, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a userHasOneMFATx) Append(values ...*entity.MFASetting) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a userHasOneMFATx) Replace(values ...*entity.MFASetting) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a userHasOneMFATx) Delete(values ...*entity.MFASetting) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a userHasOneMFATx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a userHasOneMFATx) Count() int64 {
+	return a.tx.Count()
+}
+
+func (a userHasOneMFATx) Unscoped() *userHasOneMFATx {
+	a.tx = a.tx.Unscoped()
+	return &a
+}
+
 type userDo struct{ gen.DO }
 
 func (u userDo) Debug() *userDo {
@@ -0,0 +1,87 @@
+// Code generated by proxy-generator. DO NOT EDIT.
+package repository
+
+import (
+	"context"
+	"server-template/internal/domain/repository"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+type LoginAttemptRepositoryProxy struct {
+	LoginAttemptRepository repository.LoginAttemptRepository
+}
+
+// newLoginAttemptRepositoryProxy creates a new proxy with OpenTelemetry instrumentation
+func newLoginAttemptRepositoryProxy(base repository.LoginAttemptRepository) repository.LoginAttemptRepository {
+	return &LoginAttemptRepositoryProxy{
+		LoginAttemptRepository: base,
+	}
+}
+
+// ProvideLoginAttemptRepositoryProxy returns a function that decorates the original implementation with OpenTelemetry instrumentation
+func ProvideLoginAttemptRepositoryProxy(enableTracing bool, base repository.LoginAttemptRepository) repository.LoginAttemptRepository {
+	if !enableTracing {
+		return base
+	}
+	
+	return newLoginAttemptRepositoryProxy(base)
+}
+
+func (p *LoginAttemptRepositoryProxy) RecordFailure(ctx context.Context, subject string, window time.Duration) (int, error) {
+	tracer := otel.Tracer("login-attempt-repo-tracer")
+	ctx, span := tracer.Start(ctx, "RecordFailure")
+	defer span.End()
+
+	ret0, err := p.LoginAttemptRepository.RecordFailure(ctx, subject, window)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *LoginAttemptRepositoryProxy) Block(ctx context.Context, subject string, duration time.Duration) (error) {
+	tracer := otel.Tracer("login-attempt-repo-tracer")
+	ctx, span := tracer.Start(ctx, "Block")
+	defer span.End()
+
+	err := p.LoginAttemptRepository.Block(ctx, subject, duration)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *LoginAttemptRepositoryProxy) BlockedFor(ctx context.Context, subject string) (time.Duration, error) {
+	tracer := otel.Tracer("login-attempt-repo-tracer")
+	ctx, span := tracer.Start(ctx, "BlockedFor")
+	defer span.End()
+
+	ret0, err := p.LoginAttemptRepository.BlockedFor(ctx, subject)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *LoginAttemptRepositoryProxy) Reset(ctx context.Context, subject string) (error) {
+	tracer := otel.Tracer("login-attempt-repo-tracer")
+	ctx, span := tracer.Start(ctx, "Reset")
+	defer span.End()
+
+	err := p.LoginAttemptRepository.Reset(ctx, subject)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
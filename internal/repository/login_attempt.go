@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"server-template/internal/domain/repository"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type loginAttemptRepository struct {
+	redis *redis.ClusterClient
+}
+
+func NewLoginAttemptRepository(client *redis.ClusterClient) repository.LoginAttemptRepository {
+	return &loginAttemptRepository{redis: client}
+}
+
+func (r *loginAttemptRepository) RecordFailure(ctx context.Context, subject string, window time.Duration) (int, error) {
+	key := loginFailuresKey(subject)
+
+	var incr *redis.IntCmd
+	_, err := r.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.Incr(ctx, key)
+		// 統計期間自第一次失敗起算，後續的失敗不延長
+		pipe.ExpireNX(ctx, key, window)
+
+		return nil
+	})
+	if err != nil {
+		return WrapResult(0, err, "RecordFailure")
+	}
+
+	return int(incr.Val()), nil
+}
+
+func (r *loginAttemptRepository) Block(ctx context.Context, subject string, duration time.Duration) error {
+	return WrapNoValue(r.redis.Set(ctx, loginBlockKey(subject), 1, duration).Err(), "Block")
+}
+
+func (r *loginAttemptRepository) BlockedFor(ctx context.Context, subject string) (time.Duration, error) {
+	ttl, err := r.redis.PTTL(ctx, loginBlockKey(subject)).Result()
+	if err != nil {
+		return WrapResult(time.Duration(0), err, "BlockedFor")
+	}
+
+	// key 不存在時 PTTL 回傳負值
+	return max(ttl, 0), nil
+}
+
+func (r *loginAttemptRepository) Reset(ctx context.Context, subject string) error {
+	_, err := r.redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, loginFailuresKey(subject))
+		pipe.Del(ctx, loginBlockKey(subject))
+
+		return nil
+	})
+
+	return WrapNoValue(err, "Reset")
+}
+
+func loginFailuresKey(subject string) string {
+	return fmt.Sprintf("login_failures:%s", subject)
+}
+
+func loginBlockKey(subject string) string {
+	return fmt.Sprintf("login_block:%s", subject)
+}
@@ -0,0 +1,87 @@
+// Code generated by proxy-generator. DO NOT EDIT.
+package repository
+
+import (
+	"context"
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/repository"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+type MFARepositoryProxy struct {
+	MFARepository repository.MFARepository
+}
+
+// newMFARepositoryProxy creates a new proxy with OpenTelemetry instrumentation
+func newMFARepositoryProxy(base repository.MFARepository) repository.MFARepository {
+	return &MFARepositoryProxy{
+		MFARepository: base,
+	}
+}
+
+// ProvideMFARepositoryProxy returns a function that decorates the original implementation with OpenTelemetry instrumentation
+func ProvideMFARepositoryProxy(enableTracing bool, base repository.MFARepository) repository.MFARepository {
+	if !enableTracing {
+		return base
+	}
+	
+	return newMFARepositoryProxy(base)
+}
+
+func (p *MFARepositoryProxy) Save(ctx context.Context, setting *entity.MFASetting) (error) {
+	tracer := otel.Tracer("mfa-repo-tracer")
+	ctx, span := tracer.Start(ctx, "Save")
+	defer span.End()
+
+	err := p.MFARepository.Save(ctx, setting)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *MFARepositoryProxy) Enable(ctx context.Context, userID string, recoveryCodeHashes []string) (error) {
+	tracer := otel.Tracer("mfa-repo-tracer")
+	ctx, span := tracer.Start(ctx, "Enable")
+	defer span.End()
+
+	err := p.MFARepository.Enable(ctx, userID, recoveryCodeHashes)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *MFARepositoryProxy) Delete(ctx context.Context, userID string) (error) {
+	tracer := otel.Tracer("mfa-repo-tracer")
+	ctx, span := tracer.Start(ctx, "Delete")
+	defer span.End()
+
+	err := p.MFARepository.Delete(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *MFARepositoryProxy) ConsumeRecoveryCode(ctx context.Context, userID string, codeHash string) (bool, error) {
+	tracer := otel.Tracer("mfa-repo-tracer")
+	ctx, span := tracer.Start(ctx, "ConsumeRecoveryCode")
+	defer span.End()
+
+	ret0, err := p.MFARepository.ConsumeRecoveryCode(ctx, userID, codeHash)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
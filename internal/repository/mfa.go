@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/repository"
+	"server-template/internal/repository/gen/query"
+
+	"gorm.io/gorm"
+)
+
+type mfaRepository struct {
+	q *query.Query
+}
+
+func NewMFARepository(db *gorm.DB) repository.MFARepository {
+	return &mfaRepository{q: query.Use(db)}
+}
+
+func (r *mfaRepository) Save(ctx context.Context, setting *entity.MFASetting) error {
+	err := r.q.Transaction(func(tx *query.Query) error {
+		_, err := tx.MFASetting.WithContext(ctx).Where(tx.MFASetting.UserID.Eq(setting.UserID)).Delete()
+		if err != nil {
+			return err
+		}
+
+		return tx.MFASetting.WithContext(ctx).Create(setting)
+	})
+
+	return WrapNoValue(err, "Save")
+}
+
+func (r *mfaRepository) Enable(ctx context.Context, userID string, recoveryCodeHashes []string) error {
+	err := r.q.Transaction(func(tx *query.Query) error {
+		result, err := tx.MFASetting.WithContext(ctx).
+			Where(tx.MFASetting.UserID.Eq(userID)).
+			UpdateSimple(tx.MFASetting.Enabled.Value(true), tx.MFASetting.EnabledAt.Value(time.Now()))
+		if err != nil {
+			return err
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		if _, err := tx.MFARecoveryCode.WithContext(ctx).Where(tx.MFARecoveryCode.UserID.Eq(userID)).Delete(); err != nil {
+			return err
+		}
+
+		codes := make([]*entity.MFARecoveryCode, 0, len(recoveryCodeHashes))
+		for _, hash := range recoveryCodeHashes {
+			codes = append(codes, &entity.MFARecoveryCode{UserID: userID, CodeHash: hash})
+		}
+
+		return tx.MFARecoveryCode.WithContext(ctx).Create(codes...)
+	})
+
+	return WrapNoValue(err, "Enable")
+}
+
+func (r *mfaRepository) Delete(ctx context.Context, userID string) error {
+	err := r.q.Transaction(func(tx *query.Query) error {
+		if _, err := tx.MFARecoveryCode.WithContext(ctx).Where(tx.MFARecoveryCode.UserID.Eq(userID)).Delete(); err != nil {
+			return err
+		}
+
+		_, err := tx.MFASetting.WithContext(ctx).Where(tx.MFASetting.UserID.Eq(userID)).Delete()
+
+		return err
+	})
+
+	return WrapNoValue(err, "Delete")
+}
+
+func (r *mfaRepository) ConsumeRecoveryCode(ctx context.Context, userID, codeHash string) (bool, error) {
+	code := r.q.MFARecoveryCode
+	result, err := code.WithContext(ctx).
+		Where(code.UserID.Eq(userID), code.CodeHash.Eq(codeHash), code.UsedAt.IsNull()).
+		Update(code.UsedAt, time.Now())
+	if err != nil {
+		return WrapResult(false, err, "ConsumeRecoveryCode")
+	}
+
+	return result.RowsAffected == 1, nil
+}
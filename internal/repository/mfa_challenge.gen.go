@@ -0,0 +1,87 @@
+// Code generated by proxy-generator. DO NOT EDIT.
+package repository
+
+import (
+	"context"
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/repository"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+type MFAChallengeRepositoryProxy struct {
+	MFAChallengeRepository repository.MFAChallengeRepository
+}
+
+// newMFAChallengeRepositoryProxy creates a new proxy with OpenTelemetry instrumentation
+func newMFAChallengeRepositoryProxy(base repository.MFAChallengeRepository) repository.MFAChallengeRepository {
+	return &MFAChallengeRepositoryProxy{
+		MFAChallengeRepository: base,
+	}
+}
+
+// ProvideMFAChallengeRepositoryProxy returns a function that decorates the original implementation with OpenTelemetry instrumentation
+func ProvideMFAChallengeRepositoryProxy(enableTracing bool, base repository.MFAChallengeRepository) repository.MFAChallengeRepository {
+	if !enableTracing {
+		return base
+	}
+	
+	return newMFAChallengeRepositoryProxy(base)
+}
+
+func (p *MFAChallengeRepositoryProxy) Save(ctx context.Context, challenge *entity.MFAChallenge) (error) {
+	tracer := otel.Tracer("mfa-challenge-repo-tracer")
+	ctx, span := tracer.Start(ctx, "Save")
+	defer span.End()
+
+	err := p.MFAChallengeRepository.Save(ctx, challenge)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *MFAChallengeRepositoryProxy) FindByID(ctx context.Context, id string) (*entity.MFAChallenge, error) {
+	tracer := otel.Tracer("mfa-challenge-repo-tracer")
+	ctx, span := tracer.Start(ctx, "FindByID")
+	defer span.End()
+
+	ret0, err := p.MFAChallengeRepository.FindByID(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *MFAChallengeRepositoryProxy) RecordFailure(ctx context.Context, id string) (int, error) {
+	tracer := otel.Tracer("mfa-challenge-repo-tracer")
+	ctx, span := tracer.Start(ctx, "RecordFailure")
+	defer span.End()
+
+	ret0, err := p.MFAChallengeRepository.RecordFailure(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *MFAChallengeRepositoryProxy) Delete(ctx context.Context, id string) (bool, error) {
+	tracer := otel.Tracer("mfa-challenge-repo-tracer")
+	ctx, span := tracer.Start(ctx, "Delete")
+	defer span.End()
+
+	ret0, err := p.MFAChallengeRepository.Delete(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
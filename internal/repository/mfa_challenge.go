@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/repository"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	mfaChallengeUserIDField    = "user_id"
+	mfaChallengeAttemptsField  = "attempts"
+	mfaChallengeExpiresAtField = "expires_at"
+
+	// recordMFAFailureScript 僅在 challenge 仍存在時累計失敗次數，避免建立沒有有效期的 key
+	recordMFAFailureScript = `
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return 0
+end
+return redis.call("HINCRBY", KEYS[1], ARGV[1], 1)
+`
+)
+
+type mfaChallengeRepository struct {
+	redis *redis.ClusterClient
+}
+
+func NewMFAChallengeRepository(client *redis.ClusterClient) repository.MFAChallengeRepository {
+	return &mfaChallengeRepository{redis: client}
+}
+
+// Save 以 hash 存儲 challenge，失敗次數可直接以 HINCRBY 累計
+func (r *mfaChallengeRepository) Save(ctx context.Context, challenge *entity.MFAChallenge) error {
+	ttl := time.Until(challenge.ExpiresAt)
+	if ttl <= 0 {
+		return errors.New("repository.Save failed: challenge has already expired")
+	}
+
+	key := mfaChallengeKey(challenge.ID)
+	_, err := r.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key,
+			mfaChallengeUserIDField, challenge.UserID,
+			mfaChallengeAttemptsField, challenge.Attempts,
+			mfaChallengeExpiresAtField, challenge.ExpiresAt.Unix(),
+		)
+		pipe.Expire(ctx, key, ttl)
+
+		return nil
+	})
+
+	return WrapNoValue(err, "Save")
+}
+
+func (r *mfaChallengeRepository) FindByID(ctx context.Context, id string) (*entity.MFAChallenge, error) {
+	values, err := r.redis.HGetAll(ctx, mfaChallengeKey(id)).Result()
+	if err != nil {
+		return WrapResult[*entity.MFAChallenge](nil, err, "FindByID")
+	}
+	if len(values) == 0 {
+		return WrapResult[*entity.MFAChallenge](nil, redis.Nil, "FindByID")
+	}
+
+	attempts, err := strconv.Atoi(values[mfaChallengeAttemptsField])
+	if err != nil {
+		return WrapResult[*entity.MFAChallenge](nil, err, "FindByID")
+	}
+
+	expiresAt, err := strconv.ParseInt(values[mfaChallengeExpiresAtField], 10, 64)
+	if err != nil {
+		return WrapResult[*entity.MFAChallenge](nil, err, "FindByID")
+	}
+
+	return &entity.MFAChallenge{
+		ID:        id,
+		UserID:    values[mfaChallengeUserIDField],
+		Attempts:  attempts,
+		ExpiresAt: time.Unix(expiresAt, 0),
+	}, nil
+}
+
+func (r *mfaChallengeRepository) RecordFailure(ctx context.Context, id string) (int, error) {
+	attempts, err := redis.NewScript(recordMFAFailureScript).Run(
+		ctx,
+		r.redis,
+		[]string{mfaChallengeKey(id)},
+		mfaChallengeAttemptsField,
+	).Int()
+	if err == nil && attempts == 0 {
+		err = redis.Nil
+	}
+
+	return WrapResult(attempts, err, "RecordFailure")
+}
+
+func (r *mfaChallengeRepository) Delete(ctx context.Context, id string) (bool, error) {
+	deleted, err := r.redis.Del(ctx, mfaChallengeKey(id)).Result()
+
+	return WrapResult(deleted == 1, err, "Delete")
+}
+
+func mfaChallengeKey(id string) string {
+	return fmt.Sprintf("mfa_challenge:%s", id)
+}
@@ -0,0 +1,73 @@
+// Code generated by proxy-generator. DO NOT EDIT.
+package repository
+
+import (
+	"context"
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/repository"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+type OAuthClientRepositoryProxy struct {
+	OAuthClientRepository repository.OAuthClientRepository
+}
+
+// newOAuthClientRepositoryProxy creates a new proxy with OpenTelemetry instrumentation
+func newOAuthClientRepositoryProxy(base repository.OAuthClientRepository) repository.OAuthClientRepository {
+	return &OAuthClientRepositoryProxy{
+		OAuthClientRepository: base,
+	}
+}
+
+// ProvideOAuthClientRepositoryProxy returns a function that decorates the original implementation with OpenTelemetry instrumentation
+func ProvideOAuthClientRepositoryProxy(enableTracing bool, base repository.OAuthClientRepository) repository.OAuthClientRepository {
+	if !enableTracing {
+		return base
+	}
+	
+	return newOAuthClientRepositoryProxy(base)
+}
+
+func (p *OAuthClientRepositoryProxy) Create(ctx context.Context, client *entity.OAuthClient) (error) {
+	tracer := otel.Tracer("oauth-client-repo-tracer")
+	ctx, span := tracer.Start(ctx, "Create")
+	defer span.End()
+
+	err := p.OAuthClientRepository.Create(ctx, client)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *OAuthClientRepositoryProxy) FindByID(ctx context.Context, id string) (*entity.OAuthClient, error) {
+	tracer := otel.Tracer("oauth-client-repo-tracer")
+	ctx, span := tracer.Start(ctx, "FindByID")
+	defer span.End()
+
+	ret0, err := p.OAuthClientRepository.FindByID(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *OAuthClientRepositoryProxy) Revoke(ctx context.Context, id string) (bool, error) {
+	tracer := otel.Tracer("oauth-client-repo-tracer")
+	ctx, span := tracer.Start(ctx, "Revoke")
+	defer span.End()
+
+	ret0, err := p.OAuthClientRepository.Revoke(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
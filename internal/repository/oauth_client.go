@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/repository"
+	"server-template/internal/repository/gen/query"
+
+	"gorm.io/gorm"
+)
+
+type oauthClientRepository struct {
+	q *query.Query
+}
+
+func NewOAuthClientRepository(db *gorm.DB) repository.OAuthClientRepository {
+	return &oauthClientRepository{q: query.Use(db)}
+}
+
+func (r *oauthClientRepository) Create(ctx context.Context, client *entity.OAuthClient) error {
+	return WrapNoValue(r.q.OAuthClient.WithContext(ctx).Create(client), "Create")
+}
+
+func (r *oauthClientRepository) FindByID(ctx context.Context, id string) (*entity.OAuthClient, error) {
+	client, err := r.q.OAuthClient.WithContext(ctx).Where(r.q.OAuthClient.ID.Eq(id)).First()
+
+	return WrapResult(client, err, "FindByID")
+}
+
+func (r *oauthClientRepository) Revoke(ctx context.Context, id string) (bool, error) {
+	oauthClient := r.q.OAuthClient
+	result, err := oauthClient.WithContext(ctx).
+		Where(oauthClient.ID.Eq(id), oauthClient.RevokedAt.IsNull()).
+		Update(oauthClient.RevokedAt, time.Now())
+	if err != nil {
+		return WrapResult(false, err, "Revoke")
+	}
+
+	return result.RowsAffected > 0, nil
+}
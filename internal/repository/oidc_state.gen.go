@@ -0,0 +1,60 @@
+// Code generated by proxy-generator. DO NOT EDIT.
+package repository
+
+import (
+	"context"
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/repository"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+type OIDCStateRepositoryProxy struct {
+	OIDCStateRepository repository.OIDCStateRepository
+}
+
+// newOIDCStateRepositoryProxy creates a new proxy with OpenTelemetry instrumentation
+func newOIDCStateRepositoryProxy(base repository.OIDCStateRepository) repository.OIDCStateRepository {
+	return &OIDCStateRepositoryProxy{
+		OIDCStateRepository: base,
+	}
+}
+
+// ProvideOIDCStateRepositoryProxy returns a function that decorates the original implementation with OpenTelemetry instrumentation
+func ProvideOIDCStateRepositoryProxy(enableTracing bool, base repository.OIDCStateRepository) repository.OIDCStateRepository {
+	if !enableTracing {
+		return base
+	}
+	
+	return newOIDCStateRepositoryProxy(base)
+}
+
+func (p *OIDCStateRepositoryProxy) Save(ctx context.Context, state *entity.OIDCLoginState, ttl time.Duration) (error) {
+	tracer := otel.Tracer("oidc-state-repo-tracer")
+	ctx, span := tracer.Start(ctx, "Save")
+	defer span.End()
+
+	err := p.OIDCStateRepository.Save(ctx, state, ttl)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *OIDCStateRepositoryProxy) Consume(ctx context.Context, state string) (*entity.OIDCLoginState, error) {
+	tracer := otel.Tracer("oidc-state-repo-tracer")
+	ctx, span := tracer.Start(ctx, "Consume")
+	defer span.End()
+
+	ret0, err := p.OIDCStateRepository.Consume(ctx, state)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/repository"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+type oidcStateRepository struct {
+	redis *redis.ClusterClient
+}
+
+func NewOIDCStateRepository(client *redis.ClusterClient) repository.OIDCStateRepository {
+	return &oidcStateRepository{redis: client}
+}
+
+func (r *oidcStateRepository) Save(ctx context.Context, state *entity.OIDCLoginState, ttl time.Duration) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return errors.Wrap(err, "repository.Save failed: failed to marshal OIDC state")
+	}
+
+	return WrapNoValue(r.redis.Set(ctx, oidcStateKey(state.State), data, ttl).Err(), "Save")
+}
+
+func (r *oidcStateRepository) Consume(ctx context.Context, state string) (*entity.OIDCLoginState, error) {
+	data, err := r.redis.GetDel(ctx, oidcStateKey(state)).Bytes()
+	if err != nil {
+		return WrapResult[*entity.OIDCLoginState](nil, err, "Consume")
+	}
+
+	loginState := &entity.OIDCLoginState{State: state}
+	if err := json.Unmarshal(data, loginState); err != nil {
+		return nil, errors.Wrap(err, "repository.Consume failed: failed to unmarshal OIDC state")
+	}
+
+	return loginState, nil
+}
+
+func oidcStateKey(state string) string {
+	return fmt.Sprintf("oidc_state:%s", state)
+}
@@ -0,0 +1,88 @@
+// Code generated by proxy-generator. DO NOT EDIT.
+package repository
+
+import (
+	"context"
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/repository"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+type OneTimeTokenRepositoryProxy struct {
+	OneTimeTokenRepository repository.OneTimeTokenRepository
+}
+
+// newOneTimeTokenRepositoryProxy creates a new proxy with OpenTelemetry instrumentation
+func newOneTimeTokenRepositoryProxy(base repository.OneTimeTokenRepository) repository.OneTimeTokenRepository {
+	return &OneTimeTokenRepositoryProxy{
+		OneTimeTokenRepository: base,
+	}
+}
+
+// ProvideOneTimeTokenRepositoryProxy returns a function that decorates the original implementation with OpenTelemetry instrumentation
+func ProvideOneTimeTokenRepositoryProxy(enableTracing bool, base repository.OneTimeTokenRepository) repository.OneTimeTokenRepository {
+	if !enableTracing {
+		return base
+	}
+	
+	return newOneTimeTokenRepositoryProxy(base)
+}
+
+func (p *OneTimeTokenRepositoryProxy) Save(ctx context.Context, purpose entity.OneTimeTokenPurpose, tokenHash string, userID string, ttl time.Duration) (error) {
+	tracer := otel.Tracer("one-time-token-repo-tracer")
+	ctx, span := tracer.Start(ctx, "Save")
+	defer span.End()
+
+	err := p.OneTimeTokenRepository.Save(ctx, purpose, tokenHash, userID, ttl)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *OneTimeTokenRepositoryProxy) Find(ctx context.Context, purpose entity.OneTimeTokenPurpose, tokenHash string) (string, error) {
+	tracer := otel.Tracer("one-time-token-repo-tracer")
+	ctx, span := tracer.Start(ctx, "Find")
+	defer span.End()
+
+	ret0, err := p.OneTimeTokenRepository.Find(ctx, purpose, tokenHash)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *OneTimeTokenRepositoryProxy) Consume(ctx context.Context, purpose entity.OneTimeTokenPurpose, tokenHash string) (string, error) {
+	tracer := otel.Tracer("one-time-token-repo-tracer")
+	ctx, span := tracer.Start(ctx, "Consume")
+	defer span.End()
+
+	ret0, err := p.OneTimeTokenRepository.Consume(ctx, purpose, tokenHash)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *OneTimeTokenRepositoryProxy) Revoke(ctx context.Context, purpose entity.OneTimeTokenPurpose, userID string) (error) {
+	tracer := otel.Tracer("one-time-token-repo-tracer")
+	ctx, span := tracer.Start(ctx, "Revoke")
+	defer span.End()
+
+	err := p.OneTimeTokenRepository.Revoke(ctx, purpose, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
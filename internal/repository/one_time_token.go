@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/repository"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+type oneTimeTokenRepository struct {
+	redis *redis.ClusterClient
+}
+
+func NewOneTimeTokenRepository(client *redis.ClusterClient) repository.OneTimeTokenRepository {
+	return &oneTimeTokenRepository{redis: client}
+}
+
+func (r *oneTimeTokenRepository) Save(ctx context.Context, purpose entity.OneTimeTokenPurpose, tokenHash, userID string, ttl time.Duration) error {
+	userKey := oneTimeTokenUserKey(purpose, userID)
+
+	// 使先前發出的 token 失效
+	previous, err := r.redis.GetSet(ctx, userKey, tokenHash).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return WrapNoValue(err, "Save")
+	}
+	if previous != "" {
+		if err := r.redis.Del(ctx, oneTimeTokenKey(purpose, previous)).Err(); err != nil {
+			return WrapNoValue(err, "Save")
+		}
+	}
+
+	_, err = r.redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Expire(ctx, userKey, ttl)
+		pipe.Set(ctx, oneTimeTokenKey(purpose, tokenHash), userID, ttl)
+
+		return nil
+	})
+
+	return WrapNoValue(err, "Save")
+}
+
+func (r *oneTimeTokenRepository) Find(ctx context.Context, purpose entity.OneTimeTokenPurpose, tokenHash string) (string, error) {
+	userID, err := r.redis.Get(ctx, oneTimeTokenKey(purpose, tokenHash)).Result()
+
+	return WrapResult(userID, err, "Find")
+}
+
+func (r *oneTimeTokenRepository) Consume(ctx context.Context, purpose entity.OneTimeTokenPurpose, tokenHash string) (string, error) {
+	userID, err := r.redis.GetDel(ctx, oneTimeTokenKey(purpose, tokenHash)).Result()
+
+	return WrapResult(userID, err, "Consume")
+}
+
+func (r *oneTimeTokenRepository) Revoke(ctx context.Context, purpose entity.OneTimeTokenPurpose, userID string) error {
+	userKey := oneTimeTokenUserKey(purpose, userID)
+
+	tokenHash, err := r.redis.GetDel(ctx, userKey).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil
+	}
+	if err != nil {
+		return WrapNoValue(err, "Revoke")
+	}
+
+	return WrapNoValue(r.redis.Del(ctx, oneTimeTokenKey(purpose, tokenHash)).Err(), "Revoke")
+}
+
+func oneTimeTokenKey(purpose entity.OneTimeTokenPurpose, tokenHash string) string {
+	return fmt.Sprintf("%s:%s", purpose, tokenHash)
+}
+
+func oneTimeTokenUserKey(purpose entity.OneTimeTokenPurpose, userID string) string {
+	return fmt.Sprintf("%s_user:%s", purpose, userID)
+}
@@ -0,0 +1,87 @@
+// Code generated by proxy-generator. DO NOT EDIT.
+package repository
+
+import (
+	"context"
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/repository"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+type RoleRepositoryProxy struct {
+	RoleRepository repository.RoleRepository
+}
+
+// newRoleRepositoryProxy creates a new proxy with OpenTelemetry instrumentation
+func newRoleRepositoryProxy(base repository.RoleRepository) repository.RoleRepository {
+	return &RoleRepositoryProxy{
+		RoleRepository: base,
+	}
+}
+
+// ProvideRoleRepositoryProxy returns a function that decorates the original implementation with OpenTelemetry instrumentation
+func ProvideRoleRepositoryProxy(enableTracing bool, base repository.RoleRepository) repository.RoleRepository {
+	if !enableTracing {
+		return base
+	}
+	
+	return newRoleRepositoryProxy(base)
+}
+
+func (p *RoleRepositoryProxy) FindByName(ctx context.Context, name string) (*entity.Role, error) {
+	tracer := otel.Tracer("role-repo-tracer")
+	ctx, span := tracer.Start(ctx, "FindByName")
+	defer span.End()
+
+	ret0, err := p.RoleRepository.FindByName(ctx, name)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *RoleRepositoryProxy) FindByUserID(ctx context.Context, userID string) ([]*entity.Role, error) {
+	tracer := otel.Tracer("role-repo-tracer")
+	ctx, span := tracer.Start(ctx, "FindByUserID")
+	defer span.End()
+
+	ret0, err := p.RoleRepository.FindByUserID(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *RoleRepositoryProxy) AssignToUser(ctx context.Context, userID string, roleID string) (error) {
+	tracer := otel.Tracer("role-repo-tracer")
+	ctx, span := tracer.Start(ctx, "AssignToUser")
+	defer span.End()
+
+	err := p.RoleRepository.AssignToUser(ctx, userID, roleID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *RoleRepositoryProxy) RemoveFromUser(ctx context.Context, userID string, roleID string) (bool, error) {
+	tracer := otel.Tracer("role-repo-tracer")
+	ctx, span := tracer.Start(ctx, "RemoveFromUser")
+	defer span.End()
+
+	ret0, err := p.RoleRepository.RemoveFromUser(ctx, userID, roleID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
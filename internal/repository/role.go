@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/repository"
+	"server-template/internal/repository/gen/query"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type roleRepository struct {
+	q *query.Query
+}
+
+func NewRoleRepository(db *gorm.DB) repository.RoleRepository {
+	return &roleRepository{q: query.Use(db)}
+}
+
+func (r *roleRepository) FindByName(ctx context.Context, name string) (*entity.Role, error) {
+	role, err := r.q.WithContext(ctx).Role.Where(r.q.Role.Name.Eq(name)).First()
+
+	return WrapResult(role, err, "FindByName")
+}
+
+func (r *roleRepository) FindByUserID(ctx context.Context, userID string) ([]*entity.Role, error) {
+	role, userRole := r.q.Role, r.q.UserRole
+	roles, err := role.WithContext(ctx).
+		Preload(role.Permissions).
+		Join(userRole, userRole.RoleID.EqCol(role.ID)).
+		Where(userRole.UserID.Eq(userID)).
+		Order(role.Name).
+		Find()
+
+	return WrapResult(roles, err, "FindByUserID")
+}
+
+func (r *roleRepository) AssignToUser(ctx context.Context, userID, roleID string) error {
+	err := r.q.UserRole.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&entity.UserRole{UserID: userID, RoleID: roleID})
+
+	return WrapNoValue(err, "AssignToUser")
+}
+
+func (r *roleRepository) RemoveFromUser(ctx context.Context, userID, roleID string) (bool, error) {
+	userRole := r.q.UserRole
+	result, err := userRole.WithContext(ctx).Where(userRole.UserID.Eq(userID), userRole.RoleID.Eq(roleID)).Delete()
+	if err != nil {
+		return WrapResult(false, err, "RemoveFromUser")
+	}
+
+	return result.RowsAffected > 0, nil
+}
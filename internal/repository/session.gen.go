@@ -0,0 +1,87 @@
+// Code generated by proxy-generator. DO NOT EDIT.
+package repository
+
+import (
+	"context"
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/repository"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+type SessionRepositoryProxy struct {
+	SessionRepository repository.SessionRepository
+}
+
+// newSessionRepositoryProxy creates a new proxy with OpenTelemetry instrumentation
+func newSessionRepositoryProxy(base repository.SessionRepository) repository.SessionRepository {
+	return &SessionRepositoryProxy{
+		SessionRepository: base,
+	}
+}
+
+// ProvideSessionRepositoryProxy returns a function that decorates the original implementation with OpenTelemetry instrumentation
+func ProvideSessionRepositoryProxy(enableTracing bool, base repository.SessionRepository) repository.SessionRepository {
+	if !enableTracing {
+		return base
+	}
+	
+	return newSessionRepositoryProxy(base)
+}
+
+func (p *SessionRepositoryProxy) Save(ctx context.Context, session *entity.Session) (error) {
+	tracer := otel.Tracer("session-repo-tracer")
+	ctx, span := tracer.Start(ctx, "Save")
+	defer span.End()
+
+	err := p.SessionRepository.Save(ctx, session)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *SessionRepositoryProxy) FindByID(ctx context.Context, id string) (*entity.Session, error) {
+	tracer := otel.Tracer("session-repo-tracer")
+	ctx, span := tracer.Start(ctx, "FindByID")
+	defer span.End()
+
+	ret0, err := p.SessionRepository.FindByID(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *SessionRepositoryProxy) ListByUserID(ctx context.Context, userID string) ([]*entity.Session, error) {
+	tracer := otel.Tracer("session-repo-tracer")
+	ctx, span := tracer.Start(ctx, "ListByUserID")
+	defer span.End()
+
+	ret0, err := p.SessionRepository.ListByUserID(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *SessionRepositoryProxy) Delete(ctx context.Context, userID string, id string) (error) {
+	tracer := otel.Tracer("session-repo-tracer")
+	ctx, span := tracer.Start(ctx, "Delete")
+	defer span.End()
+
+	err := p.SessionRepository.Delete(ctx, userID, id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/repository"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+type sessionRepository struct {
+	redis *redis.ClusterClient
+}
+
+func NewSessionRepository(client *redis.ClusterClient) repository.SessionRepository {
+	return &sessionRepository{redis: client}
+}
+
+// Save 存儲工作階段，並以過期時間為分數加入使用者的工作階段索引
+func (r *sessionRepository) Save(ctx context.Context, session *entity.Session) error {
+	ttl := time.Until(session.ExpiresAt)
+	if ttl <= 0 {
+		return errors.New("repository.Save failed: session has already expired")
+	}
+
+	value, err := json.Marshal(session)
+	if err != nil {
+		return WrapNoValue(err, "Save")
+	}
+
+	indexKey := userSessionsKey(session.UserID)
+	_, err = r.redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, sessionKey(session.ID), value, ttl)
+		pipe.ZAdd(ctx, indexKey, redis.Z{Score: float64(session.ExpiresAt.Unix()), Member: session.ID})
+		// 索引的有效期與最晚過期的工作階段一致
+		pipe.ExpireNX(ctx, indexKey, ttl)
+		pipe.ExpireGT(ctx, indexKey, ttl)
+
+		return nil
+	})
+
+	return WrapNoValue(err, "Save")
+}
+
+func (r *sessionRepository) FindByID(ctx context.Context, id string) (*entity.Session, error) {
+	value, err := r.redis.Get(ctx, sessionKey(id)).Bytes()
+	if err != nil {
+		return WrapResult[*entity.Session](nil, err, "FindByID")
+	}
+
+	session := new(entity.Session)
+	if err := json.Unmarshal(value, session); err != nil {
+		return WrapResult[*entity.Session](nil, err, "FindByID")
+	}
+
+	return session, nil
+}
+
+// ListByUserID 列出使用者所有未過期的工作階段，並清除索引中已失效的項目
+func (r *sessionRepository) ListByUserID(ctx context.Context, userID string) ([]*entity.Session, error) {
+	indexKey := userSessionsKey(userID)
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	if err := r.redis.ZRemRangeByScore(ctx, indexKey, "-inf", now).Err(); err != nil {
+		return WrapResult[[]*entity.Session](nil, err, "ListByUserID")
+	}
+
+	ids, err := r.redis.ZRange(ctx, indexKey, 0, -1).Result()
+	if err != nil {
+		return WrapResult[[]*entity.Session](nil, err, "ListByUserID")
+	}
+
+	// 工作階段分散在不同的 slot，以 pipeline 逐一讀取
+	cmds := make([]*redis.StringCmd, len(ids))
+	_, err = r.redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, id := range ids {
+			cmds[i] = pipe.Get(ctx, sessionKey(id))
+		}
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return WrapResult[[]*entity.Session](nil, err, "ListByUserID")
+	}
+
+	sessions := make([]*entity.Session, 0, len(ids))
+	var stale []any
+	for i, cmd := range cmds {
+		value, err := cmd.Bytes()
+		if errors.Is(err, redis.Nil) {
+			stale = append(stale, ids[i])
+
+			continue
+		}
+		if err != nil {
+			return WrapResult[[]*entity.Session](nil, err, "ListByUserID")
+		}
+
+		session := new(entity.Session)
+		if err := json.Unmarshal(value, session); err != nil {
+			return WrapResult[[]*entity.Session](nil, err, "ListByUserID")
+		}
+		sessions = append(sessions, session)
+	}
+
+	if len(stale) > 0 {
+		if err := r.redis.ZRem(ctx, indexKey, stale...).Err(); err != nil {
+			return WrapResult[[]*entity.Session](nil, err, "ListByUserID")
+		}
+	}
+
+	return sessions, nil
+}
+
+func (r *sessionRepository) Delete(ctx context.Context, userID, id string) error {
+	_, err := r.redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, sessionKey(id))
+		pipe.ZRem(ctx, userSessionsKey(userID), id)
+
+		return nil
+	})
+
+	return WrapNoValue(err, "Delete")
+}
+
+func sessionKey(id string) string {
+	return fmt.Sprintf("session:%s", id)
+}
+
+func userSessionsKey(userID string) string {
+	return fmt.Sprintf("user_sessions:%s", userID)
+}
@@ -4,6 +4,7 @@ package repository
 import (
 	"context"
 	"server-template/internal/domain/entity"
+	"server-template/internal/domain/entity/user"
 	"server-template/internal/domain/repository"
 
 	"go.opentelemetry.io/otel"
@@ -71,3 +72,87 @@ func (p *UserRepositoryProxy) FindByID(ctx context.Context, id string) (*entity.
 
 	return ret0, err
 }
+
+func (p *UserRepositoryProxy) List(ctx context.Context, filter entity.UserFilter, cursor *entity.Cursor, limit int) ([]*entity.User, error) {
+	tracer := otel.Tracer("user-repo-tracer")
+	ctx, span := tracer.Start(ctx, "List")
+	defer span.End()
+
+	ret0, err := p.UserRepository.List(ctx, filter, cursor, limit)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *UserRepositoryProxy) Count(ctx context.Context, filter entity.UserFilter) (int64, error) {
+	tracer := otel.Tracer("user-repo-tracer")
+	ctx, span := tracer.Start(ctx, "Count")
+	defer span.End()
+
+	ret0, err := p.UserRepository.Count(ctx, filter)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *UserRepositoryProxy) Update(ctx context.Context, user *entity.User) (error) {
+	tracer := otel.Tracer("user-repo-tracer")
+	ctx, span := tracer.Start(ctx, "Update")
+	defer span.End()
+
+	err := p.UserRepository.Update(ctx, user)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *UserRepositoryProxy) UpdatePassword(ctx context.Context, id string, hashedPassword string) (error) {
+	tracer := otel.Tracer("user-repo-tracer")
+	ctx, span := tracer.Start(ctx, "UpdatePassword")
+	defer span.End()
+
+	err := p.UserRepository.UpdatePassword(ctx, id, hashedPassword)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *UserRepositoryProxy) UpdateStatus(ctx context.Context, id string, status user.UserStatus) (error) {
+	tracer := otel.Tracer("user-repo-tracer")
+	ctx, span := tracer.Start(ctx, "UpdateStatus")
+	defer span.End()
+
+	err := p.UserRepository.UpdateStatus(ctx, id, status)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *UserRepositoryProxy) Erase(ctx context.Context, user *entity.User) (error) {
+	tracer := otel.Tracer("user-repo-tracer")
+	ctx, span := tracer.Start(ctx, "Erase")
+	defer span.End()
+
+	err := p.UserRepository.Erase(ctx, user)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
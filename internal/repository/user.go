@@ -2,15 +2,22 @@ package repository
 
 import (
 	"context"
+	"strings"
 
 	"server-template/internal/domain/entity"
+	"server-template/internal/domain/entity/user"
 	"server-template/internal/domain/repository"
 	"server-template/internal/repository/gen/query"
 
 	"go.uber.org/fx"
+	"gorm.io/gen"
+	"gorm.io/gen/field"
 	"gorm.io/gorm"
 )
 
+// likeEscaper 跳脫 LIKE 的萬用字元，使其依字面比對（PostgreSQL 預設以反斜線跳脫）
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
 type userRepository struct {
 	fx.In
 	q *query.Query
@@ -25,13 +32,109 @@ func (r *userRepository) Create(ctx context.Context, user *entity.User) error {
 }
 
 func (r *userRepository) FindByEmail(ctx context.Context, email string) (*entity.User, error) {
-	user, err := r.q.WithContext(ctx).User.Where(r.q.User.Email.Eq(email)).First()
+	user, err := r.q.WithContext(ctx).User.Preload(r.q.User.MFA).Where(r.q.User.Email.Eq(email)).First()
 
 	return WrapResult(user, err, "FindByEmail")
 }
 
 func (r *userRepository) FindByID(ctx context.Context, id string) (*entity.User, error) {
-	user, err := r.q.WithContext(ctx).User.Where(r.q.User.ID.Eq(id)).First()
+	user, err := r.q.WithContext(ctx).User.Preload(r.q.User.MFA).Where(r.q.User.ID.Eq(id)).First()
 
 	return WrapResult(user, err, "FindByID")
 }
+
+func (r *userRepository) List(ctx context.Context, filter entity.UserFilter, cursor *entity.Cursor, limit int) ([]*entity.User, error) {
+	conds := r.filterConds(filter)
+	if cursor != nil {
+		// keyset 分頁：取排序在游標之後的資料，避免 OFFSET 隨頁數變慢
+		conds = append(conds, field.Or(
+			r.q.User.CreatedAt.Lt(cursor.CreatedAt),
+			field.And(r.q.User.CreatedAt.Eq(cursor.CreatedAt), r.q.User.ID.Lt(cursor.ID)),
+		))
+	}
+
+	users, err := r.q.WithContext(ctx).User.
+		Preload(r.q.User.MFA).
+		Where(conds...).
+		Order(r.q.User.CreatedAt.Desc(), r.q.User.ID.Desc()).
+		Limit(limit).
+		Find()
+
+	return WrapResult(users, err, "List")
+}
+
+func (r *userRepository) Count(ctx context.Context, filter entity.UserFilter) (int64, error) {
+	count, err := r.q.WithContext(ctx).User.Where(r.filterConds(filter)...).Count()
+
+	return WrapResult(count, err, "Count")
+}
+
+// filterConds 將篩選條件轉換為查詢條件
+func (r *userRepository) filterConds(filter entity.UserFilter) []gen.Condition {
+	var conds []gen.Condition
+	if filter.Status != 0 {
+		conds = append(conds, r.q.User.Status.Eq(int(filter.Status)))
+	}
+	if filter.EmailPrefix != "" {
+		conds = append(conds, r.q.User.Email.Like(likeEscaper.Replace(filter.EmailPrefix)+"%"))
+	}
+	if filter.CreatedAfter != nil {
+		conds = append(conds, r.q.User.CreatedAt.Gte(*filter.CreatedAfter))
+	}
+	if filter.CreatedBefore != nil {
+		conds = append(conds, r.q.User.CreatedAt.Lt(*filter.CreatedBefore))
+	}
+
+	return conds
+}
+
+func (r *userRepository) Update(ctx context.Context, user *entity.User) error {
+	_, err := r.q.WithContext(ctx).User.
+		Select(r.q.User.Name, r.q.User.Email, r.q.User.PendingEmail).
+		Where(r.q.User.ID.Eq(user.ID)).
+		Updates(user)
+
+	return WrapNoValue(err, "Update")
+}
+
+func (r *userRepository) UpdatePassword(ctx context.Context, id, hashedPassword string) error {
+	_, err := r.q.WithContext(ctx).User.Where(r.q.User.ID.Eq(id)).Update(r.q.User.Password, hashedPassword)
+
+	return WrapNoValue(err, "UpdatePassword")
+}
+
+func (r *userRepository) Erase(ctx context.Context, user *entity.User) error {
+	err := r.q.Transaction(func(tx *query.Query) error {
+		_, err := tx.User.WithContext(ctx).
+			Select(tx.User.Name, tx.User.Email, tx.User.PendingEmail, tx.User.Password, tx.User.Status).
+			Where(tx.User.ID.Eq(user.ID)).
+			Updates(user)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.MFARecoveryCode.WithContext(ctx).Where(tx.MFARecoveryCode.UserID.Eq(user.ID)).Delete(); err != nil {
+			return err
+		}
+		if _, err := tx.MFASetting.WithContext(ctx).Where(tx.MFASetting.UserID.Eq(user.ID)).Delete(); err != nil {
+			return err
+		}
+		if _, err := tx.UserIdentity.WithContext(ctx).Where(tx.UserIdentity.UserID.Eq(user.ID)).Delete(); err != nil {
+			return err
+		}
+		if _, err := tx.UserRole.WithContext(ctx).Where(tx.UserRole.UserID.Eq(user.ID)).Delete(); err != nil {
+			return err
+		}
+		_, err = tx.APIKey.WithContext(ctx).Where(tx.APIKey.UserID.Eq(user.ID)).Delete()
+
+		return err
+	})
+
+	return WrapNoValue(err, "Erase")
+}
+
+func (r *userRepository) UpdateStatus(ctx context.Context, id string, status user.UserStatus) error {
+	_, err := r.q.WithContext(ctx).User.Where(r.q.User.ID.Eq(id)).Update(r.q.User.Status, int(status))
+
+	return WrapNoValue(err, "UpdateStatus")
+}
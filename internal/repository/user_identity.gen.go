@@ -0,0 +1,88 @@
+// Code generated by proxy-generator. DO NOT EDIT.
+package repository
+
+import (
+	"context"
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/repository"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+type UserIdentityRepositoryProxy struct {
+	UserIdentityRepository repository.UserIdentityRepository
+}
+
+// newUserIdentityRepositoryProxy creates a new proxy with OpenTelemetry instrumentation
+func newUserIdentityRepositoryProxy(base repository.UserIdentityRepository) repository.UserIdentityRepository {
+	return &UserIdentityRepositoryProxy{
+		UserIdentityRepository: base,
+	}
+}
+
+// ProvideUserIdentityRepositoryProxy returns a function that decorates the original implementation with OpenTelemetry instrumentation
+func ProvideUserIdentityRepositoryProxy(enableTracing bool, base repository.UserIdentityRepository) repository.UserIdentityRepository {
+	if !enableTracing {
+		return base
+	}
+	
+	return newUserIdentityRepositoryProxy(base)
+}
+
+func (p *UserIdentityRepositoryProxy) Create(ctx context.Context, identity *entity.UserIdentity) (error) {
+	tracer := otel.Tracer("user-identity-repo-tracer")
+	ctx, span := tracer.Start(ctx, "Create")
+	defer span.End()
+
+	err := p.UserIdentityRepository.Create(ctx, identity)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *UserIdentityRepositoryProxy) FindByProviderSubject(ctx context.Context, provider string, subject string) (*entity.UserIdentity, error) {
+	tracer := otel.Tracer("user-identity-repo-tracer")
+	ctx, span := tracer.Start(ctx, "FindByProviderSubject")
+	defer span.End()
+
+	ret0, err := p.UserIdentityRepository.FindByProviderSubject(ctx, provider, subject)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *UserIdentityRepositoryProxy) ListByUserID(ctx context.Context, userID string) ([]*entity.UserIdentity, error) {
+	tracer := otel.Tracer("user-identity-repo-tracer")
+	ctx, span := tracer.Start(ctx, "ListByUserID")
+	defer span.End()
+
+	ret0, err := p.UserIdentityRepository.ListByUserID(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *UserIdentityRepositoryProxy) TouchLastLogin(ctx context.Context, id string, loginAt time.Time) (error) {
+	tracer := otel.Tracer("user-identity-repo-tracer")
+	ctx, span := tracer.Start(ctx, "TouchLastLogin")
+	defer span.End()
+
+	err := p.UserIdentityRepository.TouchLastLogin(ctx, id, loginAt)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
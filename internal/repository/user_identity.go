@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/repository"
+	"server-template/internal/repository/gen/query"
+
+	"gorm.io/gorm"
+)
+
+type userIdentityRepository struct {
+	q *query.Query
+}
+
+func NewUserIdentityRepository(db *gorm.DB) repository.UserIdentityRepository {
+	return &userIdentityRepository{q: query.Use(db)}
+}
+
+func (r *userIdentityRepository) Create(ctx context.Context, identity *entity.UserIdentity) error {
+	return WrapNoValue(r.q.UserIdentity.WithContext(ctx).Create(identity), "Create")
+}
+
+func (r *userIdentityRepository) FindByProviderSubject(ctx context.Context, provider, subject string) (*entity.UserIdentity, error) {
+	userIdentity := r.q.UserIdentity
+	identity, err := userIdentity.WithContext(ctx).
+		Where(userIdentity.Provider.Eq(provider), userIdentity.Subject.Eq(subject)).
+		First()
+
+	return WrapResult(identity, err, "FindByProviderSubject")
+}
+
+func (r *userIdentityRepository) ListByUserID(ctx context.Context, userID string) ([]*entity.UserIdentity, error) {
+	userIdentity := r.q.UserIdentity
+	identities, err := userIdentity.WithContext(ctx).
+		Where(userIdentity.UserID.Eq(userID)).
+		Order(userIdentity.CreatedAt).
+		Find()
+
+	return WrapResult(identities, err, "ListByUserID")
+}
+
+func (r *userIdentityRepository) TouchLastLogin(ctx context.Context, id string, loginAt time.Time) error {
+	userIdentity := r.q.UserIdentity
+	_, err := userIdentity.WithContext(ctx).Where(userIdentity.ID.Eq(id)).Update(userIdentity.LastLoginAt, loginAt)
+
+	return WrapNoValue(err, "TouchLastLogin")
+}
@@ -0,0 +1,129 @@
+// Code generated by proxy-generator. DO NOT EDIT.
+package usecase
+
+import (
+	"context"
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/usecase"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+type AdminUseCaseProxy struct {
+	AdminUseCase usecase.AdminUseCase
+}
+
+// newAdminUseCaseProxy creates a new proxy with OpenTelemetry instrumentation
+func newAdminUseCaseProxy(base usecase.AdminUseCase) usecase.AdminUseCase {
+	return &AdminUseCaseProxy{
+		AdminUseCase: base,
+	}
+}
+
+// ProvideAdminUseCaseProxy returns a function that decorates the original implementation with OpenTelemetry instrumentation
+func ProvideAdminUseCaseProxy(enableTracing bool, base usecase.AdminUseCase) usecase.AdminUseCase {
+	if !enableTracing {
+		return base
+	}
+	
+	return newAdminUseCaseProxy(base)
+}
+
+func (p *AdminUseCaseProxy) ListUsers(ctx context.Context, filter entity.UserFilter, pageToken string, pageSize int) (*entity.UserPage, error) {
+	tracer := otel.Tracer("admin-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "ListUsers")
+	defer span.End()
+
+	ret0, err := p.AdminUseCase.ListUsers(ctx, filter, pageToken, pageSize)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AdminUseCaseProxy) GetUser(ctx context.Context, userID string) (*entity.User, error) {
+	tracer := otel.Tracer("admin-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "GetUser")
+	defer span.End()
+
+	ret0, err := p.AdminUseCase.GetUser(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AdminUseCaseProxy) SuspendUser(ctx context.Context, userID string) (*entity.User, error) {
+	tracer := otel.Tracer("admin-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "SuspendUser")
+	defer span.End()
+
+	ret0, err := p.AdminUseCase.SuspendUser(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AdminUseCaseProxy) ReactivateUser(ctx context.Context, userID string) (*entity.User, error) {
+	tracer := otel.Tracer("admin-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "ReactivateUser")
+	defer span.End()
+
+	ret0, err := p.AdminUseCase.ReactivateUser(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AdminUseCaseProxy) ExportUser(ctx context.Context, userID string) (*entity.UserExport, error) {
+	tracer := otel.Tracer("admin-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "ExportUser")
+	defer span.End()
+
+	ret0, err := p.AdminUseCase.ExportUser(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AdminUseCaseProxy) EraseUser(ctx context.Context, userID string) (*entity.User, error) {
+	tracer := otel.Tracer("admin-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "EraseUser")
+	defer span.End()
+
+	ret0, err := p.AdminUseCase.EraseUser(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AdminUseCaseProxy) ListAuditEvents(ctx context.Context, filter entity.AuditEventFilter, pageToken string, pageSize int) (*entity.AuditEventPage, error) {
+	tracer := otel.Tracer("admin-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "ListAuditEvents")
+	defer span.End()
+
+	ret0, err := p.AdminUseCase.ListAuditEvents(ctx, filter, pageToken, pageSize)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
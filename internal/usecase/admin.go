@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"context"
+
+	"server-template/internal/domain/audit"
+	"server-template/internal/domain/entity"
+	userstatus "server-template/internal/domain/entity/user"
+	"server-template/internal/domain/errs"
+	"server-template/internal/domain/repository"
+	"server-template/internal/domain/usecase"
+
+	"github.com/pkg/errors"
+	"go.uber.org/fx"
+)
+
+const (
+	defaultUserPageSize = 50
+	maxUserPageSize     = 200
+)
+
+type adminUseCase struct {
+	fx.In
+
+	userRepo       repository.UserRepository
+	roleRepo       repository.RoleRepository
+	apiKeys        repository.APIKeyRepository
+	userIdentities repository.UserIdentityRepository
+	sessions       repository.SessionRepository
+	oneTimeTokens  repository.OneTimeTokenRepository
+	auditEvents    repository.AuditEventRepository
+	audit          audit.Recorder
+}
+
+func NewAdminUseCase(
+	userRepo repository.UserRepository,
+	roleRepo repository.RoleRepository,
+	apiKeys repository.APIKeyRepository,
+	userIdentities repository.UserIdentityRepository,
+	sessions repository.SessionRepository,
+	oneTimeTokens repository.OneTimeTokenRepository,
+	auditEvents repository.AuditEventRepository,
+	audit audit.Recorder,
+) usecase.AdminUseCase {
+	return &adminUseCase{
+		userRepo:       userRepo,
+		roleRepo:       roleRepo,
+		apiKeys:        apiKeys,
+		userIdentities: userIdentities,
+		sessions:       sessions,
+		oneTimeTokens:  oneTimeTokens,
+		auditEvents:    auditEvents,
+		audit:          audit,
+	}
+}
+
+// ListUsers 依 (created_at, id) 由新到舊分頁列出使用者，pageToken 為上一頁回傳的 NextCursor
+func (uc *adminUseCase) ListUsers(ctx context.Context, filter entity.UserFilter, pageToken string, pageSize int) (*entity.UserPage, error) {
+	switch {
+	case pageSize <= 0:
+		pageSize = defaultUserPageSize
+	case pageSize > maxUserPageSize:
+		pageSize = maxUserPageSize
+	}
+
+	var cursor *entity.Cursor
+	if pageToken != "" {
+		var err error
+		if cursor, err = entity.ParseCursor(pageToken); err != nil {
+			return nil, err
+		}
+	}
+
+	// 多取一筆以判斷是否還有下一頁
+	users, err := uc.userRepo.List(ctx, filter, cursor, pageSize+1)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list users")
+	}
+
+	total, err := uc.userRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to count users")
+	}
+
+	page := &entity.UserPage{Users: users, Total: total}
+	if len(users) > pageSize {
+		page.Users = users[:pageSize]
+		last := page.Users[pageSize-1]
+		page.NextCursor = entity.NewCursor(last.CreatedAt, last.ID).Encode()
+	}
+
+	return page, nil
+}
+
+func (uc *adminUseCase) GetUser(ctx context.Context, userID string) (*entity.User, error) {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil, errs.Wrap(err, errs.KindNotFound, "user not found")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find user by ID")
+	}
+
+	return user, nil
+}
+
+// SuspendUser 停權使用者，使其無法登入；既有的工作階段需由呼叫端另行結束
+func (uc *adminUseCase) SuspendUser(ctx context.Context, userID string) (*entity.User, error) {
+	user, err := uc.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.updateStatus(ctx, user, userstatus.UserStatusSuspended); err != nil {
+		return nil, err
+	}
+	uc.recordUserEvent(ctx, entity.AuditEventUserSuspend, user.ID)
+
+	return user, nil
+}
+
+// ReactivateUser 恢復已停權的使用者，尚未驗證 email 的使用者仍需完成驗證
+func (uc *adminUseCase) ReactivateUser(ctx context.Context, userID string) (*entity.User, error) {
+	user, err := uc.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Status != userstatus.UserStatusSuspended {
+		return nil, errs.New(errs.KindFailedPrecondition, "user is not suspended")
+	}
+
+	if _, err := uc.updateStatus(ctx, user, userstatus.UserStatusActive); err != nil {
+		return nil, err
+	}
+	uc.recordUserEvent(ctx, entity.AuditEventUserReactivate, user.ID)
+
+	return user, nil
+}
+
+// updateStatus 將使用者轉換為 status 並寫入資料庫，不合法的轉換回傳 FailedPrecondition
+func (uc *adminUseCase) updateStatus(ctx context.Context, user *entity.User, status userstatus.UserStatus) (*entity.User, error) {
+	if err := user.TransitionTo(status); err != nil {
+		return nil, errs.Wrap(err, errs.KindFailedPrecondition, "user status cannot be changed")
+	}
+
+	if err := uc.userRepo.UpdateStatus(ctx, user.ID, user.Status); err != nil {
+		return nil, errors.Wrap(err, "failed to update user status")
+	}
+
+	return user, nil
+}
@@ -0,0 +1,143 @@
+// Code generated by proxy-generator. DO NOT EDIT.
+package usecase
+
+import (
+	"context"
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/usecase"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+type AdminHTTPUseCaseProxy struct {
+	AdminHTTPUseCase usecase.AdminHTTPUseCase
+}
+
+// newAdminHTTPUseCaseProxy creates a new proxy with OpenTelemetry instrumentation
+func newAdminHTTPUseCaseProxy(base usecase.AdminHTTPUseCase) usecase.AdminHTTPUseCase {
+	return &AdminHTTPUseCaseProxy{
+		AdminHTTPUseCase: base,
+	}
+}
+
+// ProvideAdminHTTPUseCaseProxy returns a function that decorates the original implementation with OpenTelemetry instrumentation
+func ProvideAdminHTTPUseCaseProxy(enableTracing bool, base usecase.AdminHTTPUseCase) usecase.AdminHTTPUseCase {
+	if !enableTracing {
+		return base
+	}
+	
+	return newAdminHTTPUseCaseProxy(base)
+}
+
+func (p *AdminHTTPUseCaseProxy) ListUsers(ctx context.Context, authorization string, filter entity.UserFilter, pageToken string, pageSize int) (*entity.UserPage, error) {
+	tracer := otel.Tracer("admin-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "ListUsers")
+	defer span.End()
+
+	ret0, err := p.AdminHTTPUseCase.ListUsers(ctx, authorization, filter, pageToken, pageSize)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AdminHTTPUseCaseProxy) GetUser(ctx context.Context, authorization string, userID string) (*entity.User, error) {
+	tracer := otel.Tracer("admin-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "GetUser")
+	defer span.End()
+
+	ret0, err := p.AdminHTTPUseCase.GetUser(ctx, authorization, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AdminHTTPUseCaseProxy) SuspendUser(ctx context.Context, authorization string, userID string) (*entity.User, int, error) {
+	tracer := otel.Tracer("admin-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "SuspendUser")
+	defer span.End()
+
+	ret0, ret1, err := p.AdminHTTPUseCase.SuspendUser(ctx, authorization, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, ret1, err
+}
+
+func (p *AdminHTTPUseCaseProxy) ReactivateUser(ctx context.Context, authorization string, userID string) (*entity.User, error) {
+	tracer := otel.Tracer("admin-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "ReactivateUser")
+	defer span.End()
+
+	ret0, err := p.AdminHTTPUseCase.ReactivateUser(ctx, authorization, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AdminHTTPUseCaseProxy) LogoutUser(ctx context.Context, authorization string, userID string) (int, error) {
+	tracer := otel.Tracer("admin-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "LogoutUser")
+	defer span.End()
+
+	ret0, err := p.AdminHTTPUseCase.LogoutUser(ctx, authorization, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AdminHTTPUseCaseProxy) ExportUser(ctx context.Context, authorization string, userID string) ([]byte, error) {
+	tracer := otel.Tracer("admin-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "ExportUser")
+	defer span.End()
+
+	ret0, err := p.AdminHTTPUseCase.ExportUser(ctx, authorization, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AdminHTTPUseCaseProxy) EraseUser(ctx context.Context, authorization string, userID string) (*entity.User, int, error) {
+	tracer := otel.Tracer("admin-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "EraseUser")
+	defer span.End()
+
+	ret0, ret1, err := p.AdminHTTPUseCase.EraseUser(ctx, authorization, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, ret1, err
+}
+
+func (p *AdminHTTPUseCaseProxy) ListAuditEvents(ctx context.Context, authorization string, filter entity.AuditEventFilter, pageToken string, pageSize int) (*entity.AuditEventPage, error) {
+	tracer := otel.Tracer("admin-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "ListAuditEvents")
+	defer span.End()
+
+	ret0, err := p.AdminHTTPUseCase.ListAuditEvents(ctx, authorization, filter, pageToken, pageSize)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
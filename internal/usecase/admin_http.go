@@ -0,0 +1,248 @@
+package usecase
+
+import (
+	"context"
+
+	"server-template/internal/domain/entity"
+	userstatus "server-template/internal/domain/entity/user"
+	"server-template/internal/domain/repository"
+	"server-template/internal/domain/usecase"
+	"server-template/internal/infrastructure/rpc"
+	"server-template/proto/pb/adminpb"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type adminHTTPUseCase struct {
+	adminRPC repository.AdminRPCRepository
+}
+
+func NewAdminHTTPUseCase(adminRPC repository.AdminRPCRepository) usecase.AdminHTTPUseCase {
+	return &adminHTTPUseCase{
+		adminRPC: adminRPC,
+	}
+}
+
+func (uc *adminHTTPUseCase) ListUsers(ctx context.Context, authorization string, filter entity.UserFilter, pageToken string, pageSize int) (*entity.UserPage, error) {
+	// 創建 gRPC 請求
+	grpcReq := &adminpb.ListUsersRequest{}
+	grpcReq.SetPageSize(int32(min(pageSize, maxUserPageSize)))
+	grpcReq.SetPageToken(pageToken)
+	grpcReq.SetEmailPrefix(filter.EmailPrefix)
+	if filter.Status != 0 {
+		grpcReq.SetStatus(filter.Status.String())
+	}
+	if filter.CreatedAfter != nil {
+		grpcReq.SetCreatedAfter(timestamppb.New(*filter.CreatedAfter))
+	}
+	if filter.CreatedBefore != nil {
+		grpcReq.SetCreatedBefore(timestamppb.New(*filter.CreatedBefore))
+	}
+
+	// 以呼叫者的憑證調用 gRPC 服務，由 Auth 服務檢查權限
+	resp, err := uc.adminRPC.ListUsers(rpc.WithAuthorization(ctx, authorization), grpcReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list users")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return nil, statusError(resp.GetStatus())
+	}
+
+	users := make([]*entity.User, 0, len(resp.GetUsers()))
+	for _, pbUser := range resp.GetUsers() {
+		users = append(users, newAdminUser(pbUser))
+	}
+
+	return &entity.UserPage{
+		Users:      users,
+		NextCursor: resp.GetNextPageToken(),
+		Total:      resp.GetTotalCount(),
+	}, nil
+}
+
+func (uc *adminHTTPUseCase) GetUser(ctx context.Context, authorization, userID string) (*entity.User, error) {
+	// 創建 gRPC 請求
+	grpcReq := &adminpb.GetUserRequest{}
+	grpcReq.SetUserId(userID)
+
+	// 以呼叫者的憑證調用 gRPC 服務，由 Auth 服務檢查權限
+	resp, err := uc.adminRPC.GetUser(rpc.WithAuthorization(ctx, authorization), grpcReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return nil, statusError(resp.GetStatus())
+	}
+
+	return newAdminUser(resp.GetUser()), nil
+}
+
+func (uc *adminHTTPUseCase) SuspendUser(ctx context.Context, authorization, userID string) (*entity.User, int, error) {
+	// 創建 gRPC 請求
+	grpcReq := &adminpb.SuspendUserRequest{}
+	grpcReq.SetUserId(userID)
+
+	// 以呼叫者的憑證調用 gRPC 服務，由 Auth 服務檢查權限
+	resp, err := uc.adminRPC.SuspendUser(rpc.WithAuthorization(ctx, authorization), grpcReq)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to suspend user")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return nil, 0, statusError(resp.GetStatus())
+	}
+
+	return newAdminUser(resp.GetUser()), int(resp.GetRevokedSessions()), nil
+}
+
+func (uc *adminHTTPUseCase) ReactivateUser(ctx context.Context, authorization, userID string) (*entity.User, error) {
+	// 創建 gRPC 請求
+	grpcReq := &adminpb.ReactivateUserRequest{}
+	grpcReq.SetUserId(userID)
+
+	// 以呼叫者的憑證調用 gRPC 服務，由 Auth 服務檢查權限
+	resp, err := uc.adminRPC.ReactivateUser(rpc.WithAuthorization(ctx, authorization), grpcReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to reactivate user")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return nil, statusError(resp.GetStatus())
+	}
+
+	return newAdminUser(resp.GetUser()), nil
+}
+
+func (uc *adminHTTPUseCase) LogoutUser(ctx context.Context, authorization, userID string) (int, error) {
+	// 創建 gRPC 請求
+	grpcReq := &adminpb.LogoutUserRequest{}
+	grpcReq.SetUserId(userID)
+
+	// 以呼叫者的憑證調用 gRPC 服務，由 Auth 服務檢查權限
+	resp, err := uc.adminRPC.LogoutUser(rpc.WithAuthorization(ctx, authorization), grpcReq)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to log out user")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return 0, statusError(resp.GetStatus())
+	}
+
+	return int(resp.GetRevokedSessions()), nil
+}
+
+func (uc *adminHTTPUseCase) ExportUser(ctx context.Context, authorization, userID string) ([]byte, error) {
+	// 創建 gRPC 請求
+	grpcReq := &adminpb.ExportUserRequest{}
+	grpcReq.SetUserId(userID)
+
+	// 以呼叫者的憑證調用 gRPC 服務，由 Auth 服務檢查權限
+	resp, err := uc.adminRPC.ExportUser(rpc.WithAuthorization(ctx, authorization), grpcReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to export user")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return nil, statusError(resp.GetStatus())
+	}
+
+	return resp.GetArchive(), nil
+}
+
+func (uc *adminHTTPUseCase) EraseUser(ctx context.Context, authorization, userID string) (*entity.User, int, error) {
+	// 創建 gRPC 請求
+	grpcReq := &adminpb.EraseUserRequest{}
+	grpcReq.SetUserId(userID)
+
+	// 以呼叫者的憑證調用 gRPC 服務，由 Auth 服務檢查權限
+	resp, err := uc.adminRPC.EraseUser(rpc.WithAuthorization(ctx, authorization), grpcReq)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to erase user")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return nil, 0, statusError(resp.GetStatus())
+	}
+
+	return newAdminUser(resp.GetUser()), int(resp.GetRevokedSessions()), nil
+}
+
+func (uc *adminHTTPUseCase) ListAuditEvents(ctx context.Context, authorization string, filter entity.AuditEventFilter, pageToken string, pageSize int) (*entity.AuditEventPage, error) {
+	// 創建 gRPC 請求
+	grpcReq := &adminpb.ListAuditEventsRequest{}
+	grpcReq.SetPageSize(int32(min(pageSize, maxAuditEventPageSize)))
+	grpcReq.SetPageToken(pageToken)
+	grpcReq.SetUserId(filter.UserID)
+	if filter.CreatedAfter != nil {
+		grpcReq.SetCreatedAfter(timestamppb.New(*filter.CreatedAfter))
+	}
+	if filter.CreatedBefore != nil {
+		grpcReq.SetCreatedBefore(timestamppb.New(*filter.CreatedBefore))
+	}
+
+	// 以呼叫者的憑證調用 gRPC 服務，由 Auth 服務檢查權限
+	resp, err := uc.adminRPC.ListAuditEvents(rpc.WithAuthorization(ctx, authorization), grpcReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list audit events")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return nil, statusError(resp.GetStatus())
+	}
+
+	events := make([]*entity.AuditEvent, 0, len(resp.GetEvents()))
+	for _, pbEvent := range resp.GetEvents() {
+		events = append(events, &entity.AuditEvent{
+			ID:        pbEvent.GetId(),
+			Type:      entity.AuditEventType(pbEvent.GetType()),
+			ActorID:   pbEvent.GetActorId(),
+			SubjectID: pbEvent.GetSubjectId(),
+			IPAddress: pbEvent.GetIpAddress(),
+			UserAgent: pbEvent.GetUserAgent(),
+			Outcome:   entity.AuditOutcome(pbEvent.GetOutcome()),
+			Reason:    pbEvent.GetReason(),
+			TraceID:   pbEvent.GetTraceId(),
+			CreatedAt: pbEvent.GetCreatedAt().AsTime(),
+		})
+	}
+
+	return &entity.AuditEventPage{
+		Events:     events,
+		NextCursor: resp.GetNextPageToken(),
+	}, nil
+}
+
+// newAdminUser 將管理介面的 protobuf 使用者訊息轉換為使用者實體
+func newAdminUser(pbUser *adminpb.User) *entity.User {
+	// 無法辨識的狀態保留為零值，不視為 active
+	status, _ := userstatus.ParseUserStatus(pbUser.GetStatus())
+
+	user := &entity.User{
+		ID:        pbUser.GetId(),
+		Name:      pbUser.GetName(),
+		Email:     pbUser.GetEmail(),
+		Status:    status,
+		CreatedAt: pbUser.GetCreatedAt().AsTime(),
+		UpdatedAt: pbUser.GetUpdatedAt().AsTime(),
+	}
+	if pbUser.HasPendingEmail() {
+		pendingEmail := pbUser.GetPendingEmail()
+		user.PendingEmail = &pendingEmail
+	}
+	if pbUser.GetMfaEnabled() {
+		user.MFA = &entity.MFASetting{UserID: user.ID, Enabled: true}
+	}
+
+	return user
+}
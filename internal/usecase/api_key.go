@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/base64"
+	"slices"
+	"strings"
+	"time"
+
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/errs"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// apiKeyPrefix 讓金鑰易於在程式碼與紀錄中被辨識
+	apiKeyPrefix = "sk_"
+	// apiKeyIDBytes 為金鑰前綴中隨機識別值的位元組長度，編碼後為 16 個字元
+	apiKeyIDBytes = 10
+	// apiKeySecretBytes 為金鑰 secret 的隨機位元組長度
+	apiKeySecretBytes = 32
+	// apiKeyLastUsedResolution 為更新最後使用時間的最小間隔，避免每個請求都寫入資料庫
+	apiKeyLastUsedResolution = time.Minute
+)
+
+var errAPIKeyInvalid = errs.New(errs.KindInvalidCredentials, "API key is invalid, expired or has been revoked")
+
+// CreateAPIKey 為使用者建立 API key，scopes 需為使用者目前擁有且在 callerPermissions（呼叫者 token 帶有的權限）之中的權限。
+// 回傳的金鑰只會出現這一次，之後僅能以前綴辨識。
+func (uc *authUseCase) CreateAPIKey(ctx context.Context, userID, name string, scopes []string, expiresAt *time.Time, callerPermissions []string) (*entity.APIKey, string, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, "", errs.FieldError("name", errors.New("name is required"))
+	}
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return nil, "", errs.FieldError("expires_at", errors.New("expiry must be in the future"))
+	}
+
+	access, err := uc.GetUserAccess(ctx, userID)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(access.Permissions, scope) {
+			return nil, "", errs.New(errs.KindPermissionDenied, "cannot grant permission "+scope+" that the user does not have")
+		}
+		if !slices.Contains(callerPermissions, scope) {
+			return nil, "", errs.New(errs.KindPermissionDenied, "cannot grant permission "+scope+" that the caller does not have")
+		}
+	}
+
+	prefix, err := randomAPIKeyPart(apiKeyIDBytes, base32.StdEncoding.WithPadding(base32.NoPadding))
+	if err != nil {
+		return nil, "", err
+	}
+	secret, err := randomAPIKeyPart(apiKeySecretBytes, base64.RawURLEncoding)
+	if err != nil {
+		return nil, "", err
+	}
+
+	key := &entity.APIKey{
+		UserID:     userID,
+		Name:       name,
+		Prefix:     apiKeyPrefix + strings.ToLower(prefix),
+		SecretHash: hashOneTimeToken(secret),
+		Scopes:     strings.Join(slices.Compact(slices.Sorted(slices.Values(scopes))), " "),
+		ExpiresAt:  expiresAt,
+	}
+	if err := uc.apiKeys.Create(ctx, key); err != nil {
+		return nil, "", errors.Wrap(err, "failed to create API key")
+	}
+
+	return key, key.Prefix + "." + secret, nil
+}
+
+// ListAPIKeys 回傳使用者尚未撤銷的 API key
+func (uc *authUseCase) ListAPIKeys(ctx context.Context, userID string) ([]*entity.APIKey, error) {
+	keys, err := uc.apiKeys.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list API keys")
+	}
+
+	return keys, nil
+}
+
+// RevokeAPIKey 撤銷使用者的 API key
+func (uc *authUseCase) RevokeAPIKey(ctx context.Context, userID, keyID string) error {
+	revoked, err := uc.apiKeys.Revoke(ctx, userID, keyID)
+	if err != nil {
+		return errors.Wrap(err, "failed to revoke API key")
+	}
+	if !revoked {
+		return errs.New(errs.KindNotFound, "API key not found")
+	}
+
+	return nil
+}
+
+// AuthenticateAPIKey 驗證 API key，回傳的權限為金鑰權限與擁有者目前權限的交集，
+// 使擁有者失去角色後其金鑰也隨即失去對應的權限
+func (uc *authUseCase) AuthenticateAPIKey(ctx context.Context, rawKey string) (*entity.APIKeyPrincipal, error) {
+	prefix, secret, ok := strings.Cut(rawKey, ".")
+	if !ok || !strings.HasPrefix(prefix, apiKeyPrefix) || secret == "" {
+		return nil, errAPIKeyInvalid
+	}
+
+	key, err := uc.apiKeys.FindByPrefix(ctx, prefix)
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil, errAPIKeyInvalid
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find API key")
+	}
+
+	now := time.Now()
+	if subtle.ConstantTimeCompare([]byte(hashOneTimeToken(secret)), []byte(key.SecretHash)) != 1 || !key.IsUsable(now) {
+		return nil, errAPIKeyInvalid
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, key.UserID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find API key owner")
+	}
+	if !user.IsActive() {
+		return nil, errAPIKeyInvalid
+	}
+
+	access, err := uc.GetUserAccess(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions := []string{}
+	for _, scope := range key.ScopeList() {
+		if slices.Contains(access.Permissions, scope) {
+			permissions = append(permissions, scope)
+		}
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= apiKeyLastUsedResolution {
+		if err := uc.apiKeys.TouchLastUsed(ctx, key.ID, now); err != nil {
+			return nil, errors.Wrap(err, "failed to update API key last used time")
+		}
+		key.LastUsedAt = &now
+	}
+
+	return &entity.APIKeyPrincipal{Key: key, User: user, Permissions: permissions}, nil
+}
+
+func randomAPIKeyPart(size int, encoding interface{ EncodeToString(src []byte) string }) (string, error) {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		return "", errors.Wrap(err, "failed to generate API key")
+	}
+
+	return encoding.EncodeToString(buf), nil
+}
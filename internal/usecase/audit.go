@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+
+	"server-template/internal/domain/entity"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultAuditEventPageSize = 100
+	maxAuditEventPageSize     = 500
+)
+
+// ListAuditEvents 依 (created_at, id) 由新到舊分頁列出稽核事件，pageToken 為上一頁回傳的 NextCursor
+func (uc *adminUseCase) ListAuditEvents(ctx context.Context, filter entity.AuditEventFilter, pageToken string, pageSize int) (*entity.AuditEventPage, error) {
+	switch {
+	case pageSize <= 0:
+		pageSize = defaultAuditEventPageSize
+	case pageSize > maxAuditEventPageSize:
+		pageSize = maxAuditEventPageSize
+	}
+
+	var cursor *entity.Cursor
+	if pageToken != "" {
+		var err error
+		if cursor, err = entity.ParseCursor(pageToken); err != nil {
+			return nil, err
+		}
+	}
+
+	// 多取一筆以判斷是否還有下一頁
+	events, err := uc.auditEvents.List(ctx, filter, cursor, pageSize+1)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list audit events")
+	}
+
+	page := &entity.AuditEventPage{Events: events}
+	if len(events) > pageSize {
+		page.Events = events[:pageSize]
+		last := page.Events[pageSize-1]
+		page.NextCursor = entity.NewCursor(last.CreatedAt, last.ID).Encode()
+	}
+
+	return page, nil
+}
+
+// recordUserEvent 記錄管理員對使用者執行的操作，執行者由 ctx 中的呼叫端身分補上
+func (uc *adminUseCase) recordUserEvent(ctx context.Context, eventType entity.AuditEventType, userID string) {
+	uc.audit.Record(ctx, &entity.AuditEvent{
+		Type:      eventType,
+		SubjectID: userID,
+		Outcome:   entity.AuditOutcomeSuccess,
+	})
+}
+
+// recordUserEvent 記錄使用者對自己帳號執行的操作
+func (uc *authUseCase) recordUserEvent(ctx context.Context, eventType entity.AuditEventType, userID string) {
+	uc.audit.Record(ctx, &entity.AuditEvent{
+		Type:      eventType,
+		ActorID:   userID,
+		SubjectID: userID,
+		Outcome:   entity.AuditOutcomeSuccess,
+	})
+}
+
+// recordLoginDenied 記錄未通過驗證的登入，subjectID 為嘗試登入的使用者，帳號不存在時為空
+func (uc *authUseCase) recordLoginDenied(ctx context.Context, subjectID string, client entity.ClientInfo, reason string) {
+	uc.audit.Record(ctx, &entity.AuditEvent{
+		Type:      entity.AuditEventLogin,
+		SubjectID: subjectID,
+		IPAddress: client.IPAddress,
+		UserAgent: client.UserAgent,
+		Outcome:   entity.AuditOutcomeFailure,
+		Reason:    reason,
+	})
+}
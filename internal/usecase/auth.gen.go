@@ -5,6 +5,7 @@ import (
 	"context"
 	"server-template/internal/domain/entity"
 	"server-template/internal/domain/usecase"
+	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
@@ -30,12 +31,12 @@ func ProvideAuthUseCaseProxy(enableTracing bool, base usecase.AuthUseCase) useca
 	return newAuthUseCaseProxy(base)
 }
 
-func (p *AuthUseCaseProxy) Register(ctx context.Context, email string, hashedPassword string) (*entity.User, error) {
+func (p *AuthUseCaseProxy) Register(ctx context.Context, email string, hashedPassword string, name string) (*entity.User, error) {
 	tracer := otel.Tracer("auth-usecase-tracer")
 	ctx, span := tracer.Start(ctx, "Register")
 	defer span.End()
 
-	ret0, err := p.AuthUseCase.Register(ctx, email, hashedPassword)
+	ret0, err := p.AuthUseCase.Register(ctx, email, hashedPassword, name)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
@@ -44,12 +45,12 @@ func (p *AuthUseCaseProxy) Register(ctx context.Context, email string, hashedPas
 	return ret0, err
 }
 
-func (p *AuthUseCaseProxy) Login(ctx context.Context, email string, hashedPassword string) (*entity.User, error) {
+func (p *AuthUseCaseProxy) Login(ctx context.Context, email string, hashedPassword string, client entity.ClientInfo) (*entity.User, error) {
 	tracer := otel.Tracer("auth-usecase-tracer")
 	ctx, span := tracer.Start(ctx, "Login")
 	defer span.End()
 
-	ret0, err := p.AuthUseCase.Login(ctx, email, hashedPassword)
+	ret0, err := p.AuthUseCase.Login(ctx, email, hashedPassword, client)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
@@ -71,3 +72,339 @@ func (p *AuthUseCaseProxy) GetUserByID(ctx context.Context, userID string) (*ent
 
 	return ret0, err
 }
+
+func (p *AuthUseCaseProxy) RequestPasswordReset(ctx context.Context, email string) (error) {
+	tracer := otel.Tracer("auth-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "RequestPasswordReset")
+	defer span.End()
+
+	err := p.AuthUseCase.RequestPasswordReset(ctx, email)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *AuthUseCaseProxy) ResetPassword(ctx context.Context, token string, newPassword string) (*entity.User, error) {
+	tracer := otel.Tracer("auth-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "ResetPassword")
+	defer span.End()
+
+	ret0, err := p.AuthUseCase.ResetPassword(ctx, token, newPassword)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AuthUseCaseProxy) VerifyEmail(ctx context.Context, token string) (*entity.User, error) {
+	tracer := otel.Tracer("auth-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "VerifyEmail")
+	defer span.End()
+
+	ret0, err := p.AuthUseCase.VerifyEmail(ctx, token)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AuthUseCaseProxy) ResendVerification(ctx context.Context, email string) (error) {
+	tracer := otel.Tracer("auth-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "ResendVerification")
+	defer span.End()
+
+	err := p.AuthUseCase.ResendVerification(ctx, email)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *AuthUseCaseProxy) EnrollMFA(ctx context.Context, userID string) (*entity.MFAEnrollment, error) {
+	tracer := otel.Tracer("auth-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "EnrollMFA")
+	defer span.End()
+
+	ret0, err := p.AuthUseCase.EnrollMFA(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AuthUseCaseProxy) ConfirmMFA(ctx context.Context, userID string, code string) ([]string, error) {
+	tracer := otel.Tracer("auth-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "ConfirmMFA")
+	defer span.End()
+
+	ret0, err := p.AuthUseCase.ConfirmMFA(ctx, userID, code)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AuthUseCaseProxy) DisableMFA(ctx context.Context, userID string, code string) (error) {
+	tracer := otel.Tracer("auth-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "DisableMFA")
+	defer span.End()
+
+	err := p.AuthUseCase.DisableMFA(ctx, userID, code)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *AuthUseCaseProxy) CreateMFAChallenge(ctx context.Context, userID string) (string, error) {
+	tracer := otel.Tracer("auth-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "CreateMFAChallenge")
+	defer span.End()
+
+	ret0, err := p.AuthUseCase.CreateMFAChallenge(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AuthUseCaseProxy) VerifyMFA(ctx context.Context, challengeID string, code string) (*entity.User, error) {
+	tracer := otel.Tracer("auth-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "VerifyMFA")
+	defer span.End()
+
+	ret0, err := p.AuthUseCase.VerifyMFA(ctx, challengeID, code)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AuthUseCaseProxy) UnlockAccount(ctx context.Context, email string) (error) {
+	tracer := otel.Tracer("auth-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "UnlockAccount")
+	defer span.End()
+
+	err := p.AuthUseCase.UnlockAccount(ctx, email)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *AuthUseCaseProxy) GetUserAccess(ctx context.Context, userID string) (*entity.Access, error) {
+	tracer := otel.Tracer("auth-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "GetUserAccess")
+	defer span.End()
+
+	ret0, err := p.AuthUseCase.GetUserAccess(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AuthUseCaseProxy) GrantRole(ctx context.Context, userID string, roleName string) (error) {
+	tracer := otel.Tracer("auth-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "GrantRole")
+	defer span.End()
+
+	err := p.AuthUseCase.GrantRole(ctx, userID, roleName)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *AuthUseCaseProxy) RevokeRole(ctx context.Context, userID string, roleName string) (error) {
+	tracer := otel.Tracer("auth-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "RevokeRole")
+	defer span.End()
+
+	err := p.AuthUseCase.RevokeRole(ctx, userID, roleName)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *AuthUseCaseProxy) CreateAPIKey(ctx context.Context, userID string, name string, scopes []string, expiresAt *time.Time, callerPermissions []string) (*entity.APIKey, string, error) {
+	tracer := otel.Tracer("auth-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "CreateAPIKey")
+	defer span.End()
+
+	ret0, ret1, err := p.AuthUseCase.CreateAPIKey(ctx, userID, name, scopes, expiresAt, callerPermissions)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, ret1, err
+}
+
+func (p *AuthUseCaseProxy) ListAPIKeys(ctx context.Context, userID string) ([]*entity.APIKey, error) {
+	tracer := otel.Tracer("auth-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "ListAPIKeys")
+	defer span.End()
+
+	ret0, err := p.AuthUseCase.ListAPIKeys(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AuthUseCaseProxy) RevokeAPIKey(ctx context.Context, userID string, keyID string) (error) {
+	tracer := otel.Tracer("auth-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "RevokeAPIKey")
+	defer span.End()
+
+	err := p.AuthUseCase.RevokeAPIKey(ctx, userID, keyID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *AuthUseCaseProxy) AuthenticateAPIKey(ctx context.Context, rawKey string) (*entity.APIKeyPrincipal, error) {
+	tracer := otel.Tracer("auth-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "AuthenticateAPIKey")
+	defer span.End()
+
+	ret0, err := p.AuthUseCase.AuthenticateAPIKey(ctx, rawKey)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AuthUseCaseProxy) CreateOAuthClient(ctx context.Context, name string, scopes []string, grantTypes []string, callerPermissions []string) (*entity.OAuthClient, string, error) {
+	tracer := otel.Tracer("auth-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "CreateOAuthClient")
+	defer span.End()
+
+	ret0, ret1, err := p.AuthUseCase.CreateOAuthClient(ctx, name, scopes, grantTypes, callerPermissions)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, ret1, err
+}
+
+func (p *AuthUseCaseProxy) RevokeOAuthClient(ctx context.Context, clientID string) (error) {
+	tracer := otel.Tracer("auth-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "RevokeOAuthClient")
+	defer span.End()
+
+	err := p.AuthUseCase.RevokeOAuthClient(ctx, clientID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *AuthUseCaseProxy) AuthenticateOAuthClient(ctx context.Context, credentials entity.ClientCredentials) (*entity.OAuthClient, error) {
+	tracer := otel.Tracer("auth-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "AuthenticateOAuthClient")
+	defer span.End()
+
+	ret0, err := p.AuthUseCase.AuthenticateOAuthClient(ctx, credentials)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AuthUseCaseProxy) StartOIDCLogin(ctx context.Context, providerName string) (string, string, error) {
+	tracer := otel.Tracer("auth-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "StartOIDCLogin")
+	defer span.End()
+
+	ret0, ret1, err := p.AuthUseCase.StartOIDCLogin(ctx, providerName)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, ret1, err
+}
+
+func (p *AuthUseCaseProxy) CompleteOIDCLogin(ctx context.Context, providerName string, state string, code string) (*entity.User, error) {
+	tracer := otel.Tracer("auth-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "CompleteOIDCLogin")
+	defer span.End()
+
+	ret0, err := p.AuthUseCase.CompleteOIDCLogin(ctx, providerName, state, code)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AuthUseCaseProxy) UpdateProfile(ctx context.Context, userID string, name *string, email *string) (*entity.User, error) {
+	tracer := otel.Tracer("auth-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "UpdateProfile")
+	defer span.End()
+
+	ret0, err := p.AuthUseCase.UpdateProfile(ctx, userID, name, email)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AuthUseCaseProxy) ChangePassword(ctx context.Context, userID string, currentPassword string, newPassword string) (error) {
+	tracer := otel.Tracer("auth-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "ChangePassword")
+	defer span.End()
+
+	err := p.AuthUseCase.ChangePassword(ctx, userID, currentPassword, newPassword)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
@@ -2,83 +2,267 @@ package usecase
 
 import (
 	"context"
+	"sync"
 
+	"server-template/config"
+	"server-template/internal/domain/audit"
 	"server-template/internal/domain/entity"
+	userstatus "server-template/internal/domain/entity/user"
+	"server-template/internal/domain/errs"
+	"server-template/internal/domain/identity"
+	"server-template/internal/domain/notification"
+	"server-template/internal/domain/password"
 	"server-template/internal/domain/repository"
 	"server-template/internal/domain/usecase"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"go.uber.org/fx"
-	"golang.org/x/crypto/bcrypt"
-	"gorm.io/gorm"
 )
 
+// errInvalidCredentials 不區分帳號不存在或密碼錯誤，避免洩漏帳號是否存在
+var errInvalidCredentials = errs.New(errs.KindInvalidCredentials, "invalid credentials")
+
 type authUseCase struct {
 	fx.In
 
-	userRepo repository.UserRepository
+	cfg               *config.Config
+	userRepo          repository.UserRepository
+	oneTimeTokens     repository.OneTimeTokenRepository
+	mfaRepo           repository.MFARepository
+	mfaChallenges     repository.MFAChallengeRepository
+	loginAttempts     repository.LoginAttemptRepository
+	roleRepo          repository.RoleRepository
+	apiKeys           repository.APIKeyRepository
+	oauthClients      repository.OAuthClientRepository
+	userIdentities    repository.UserIdentityRepository
+	oidcStates        repository.OIDCStateRepository
+	identityProviders identity.Providers
+	passwords         password.Hasher
+	passwordPolicy    password.Policy
+	notifier          notification.Notifier
+	audit             audit.Recorder
+
+	// dummyPasswordHash 為以目前設定雜湊的假密碼，帳號不存在時用於驗證，使回應時間與密碼錯誤相同
+	dummyPasswordHash func() (string, error)
 }
 
-func NewAuthUseCase(userRepo repository.UserRepository) usecase.AuthUseCase {
+func NewAuthUseCase(
+	cfg *config.Config,
+	userRepo repository.UserRepository,
+	oneTimeTokens repository.OneTimeTokenRepository,
+	mfaRepo repository.MFARepository,
+	mfaChallenges repository.MFAChallengeRepository,
+	loginAttempts repository.LoginAttemptRepository,
+	roleRepo repository.RoleRepository,
+	apiKeys repository.APIKeyRepository,
+	oauthClients repository.OAuthClientRepository,
+	userIdentities repository.UserIdentityRepository,
+	oidcStates repository.OIDCStateRepository,
+	identityProviders identity.Providers,
+	passwords password.Hasher,
+	passwordPolicy password.Policy,
+	notifier notification.Notifier,
+	audit audit.Recorder,
+) usecase.AuthUseCase {
 	return &authUseCase{
-		userRepo: userRepo,
+		cfg:               cfg,
+		userRepo:          userRepo,
+		oneTimeTokens:     oneTimeTokens,
+		mfaRepo:           mfaRepo,
+		mfaChallenges:     mfaChallenges,
+		loginAttempts:     loginAttempts,
+		roleRepo:          roleRepo,
+		apiKeys:           apiKeys,
+		oauthClients:      oauthClients,
+		userIdentities:    userIdentities,
+		oidcStates:        oidcStates,
+		identityProviders: identityProviders,
+		passwords:         passwords,
+		passwordPolicy:    passwordPolicy,
+		notifier:          notifier,
+		audit:             audit,
+		dummyPasswordHash: sync.OnceValues(func() (string, error) {
+			return passwords.Hash(uuid.New().String())
+		}),
 	}
 }
 
-func (uc *authUseCase) Register(ctx context.Context, email, hashedPassword string) (*entity.User, error) {
+func (uc *authUseCase) Register(ctx context.Context, email, hashedPassword, name string) (*entity.User, error) {
 	// 檢查用戶是否已存在
 	existingUser, err := uc.userRepo.FindByEmail(ctx, email)
-	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+	if err != nil && !errors.Is(err, errs.ErrNotFound) {
 		return nil, errors.Wrap(err, "failed to check existing user")
 	}
 	if existingUser != nil {
-		return nil, errors.New("user already exists")
+		return nil, errs.New(errs.KindAlreadyExists, "user already exists")
 	}
 
-	// 創建新用戶
+	// 創建新用戶，需驗證 email 後才能登入
 	user := &entity.User{
 		ID:       uuid.New().String(),
+		Name:     name,
 		Email:    email,
 		Password: hashedPassword,
+		Status:   userstatus.UserStatusPendingVerification,
 	}
 
 	// 驗證用戶資料
 	if err := user.Validate(); err != nil {
 		return nil, errors.Wrap(err, "invalid user data")
 	}
+	if err := uc.passwordPolicy.Validate(ctx, "password", user.Password, user.Email, user.Name); err != nil {
+		return nil, errors.Wrap(err, "invalid password")
+	}
 
 	// 加密密碼
-	if err := user.HashPassword(); err != nil {
-		return nil, errors.Wrap(err, "vertify password failed")
+	if err := uc.hashPassword(user); err != nil {
+		return nil, err
 	}
 
 	if err := uc.userRepo.Create(ctx, user); err != nil {
 		return nil, errors.Wrap(err, "failed to create user")
 	}
 
+	if err := uc.sendVerification(ctx, user); err != nil {
+		return nil, err
+	}
+
+	uc.audit.Record(ctx, &entity.AuditEvent{
+		Type:      entity.AuditEventRegister,
+		ActorID:   user.ID,
+		SubjectID: user.ID,
+		Outcome:   entity.AuditOutcomeSuccess,
+	})
+
 	return user, nil
 }
 
-func (uc *authUseCase) Login(ctx context.Context, email, hashedPassword string) (*entity.User, error) {
+func (uc *authUseCase) Login(ctx context.Context, email, hashedPassword string, client entity.ClientInfo) (*entity.User, error) {
+	// 帳號或 IP 連續登入失敗時暫時封鎖
+	subjects := uc.loginSubjects(email, client.IPAddress)
+	if err := uc.checkLoginThrottle(ctx, subjects); err != nil {
+		var throttled *entity.LoginThrottledError
+		if errors.As(err, &throttled) {
+			uc.recordLoginDenied(ctx, "", client, throttled.Error())
+		}
+
+		return nil, err
+	}
+
 	user, err := uc.userRepo.FindByEmail(ctx, email)
-	if err != nil {
+	if err != nil && !errors.Is(err, errs.ErrNotFound) {
 		return nil, errors.Wrap(err, "failed to find user")
 	}
 
-	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(hashedPassword))
-	if err != nil {
-		return nil, errors.New("invalid credentials")
+	// 帳號不存在與密碼錯誤同樣計入失敗次數，避免洩漏帳號是否存在
+	var match, needsRehash bool
+	if user != nil {
+		match, needsRehash, err = uc.passwords.Verify(hashedPassword, user.Password)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to verify password")
+		}
+	} else if err := uc.verifyDummyPassword(hashedPassword); err != nil {
+		return nil, err
+	}
+	if !match {
+		var subjectID string
+		if user != nil {
+			subjectID = user.ID
+		}
+		uc.recordLoginDenied(ctx, subjectID, client, "invalid credentials")
+
+		return nil, uc.loginFailed(ctx, subjects)
+	}
+
+	// 登入成功後清除帳號的失敗次數，IP 的失敗次數則保留至統計期間結束；
+	// 啟用 MFA 的帳號待 VerifyMFA 通過後才清除，避免以重新登入重置 MFA 的失敗次數
+	if !user.MFAEnabled() {
+		if err := uc.loginAttempts.Reset(ctx, subjects[0].key); err != nil {
+			return nil, errors.Wrap(err, "failed to reset login failures")
+		}
+	}
+
+	if err := checkUserStatus(user); err != nil {
+		uc.recordLoginDenied(ctx, user.ID, client, err.Error())
+
+		return nil, err
+	}
+
+	// 以舊演算法或舊參數雜湊的密碼於登入成功時升級
+	if needsRehash {
+		user.Password = hashedPassword
+		if err := uc.hashPassword(user); err != nil {
+			return nil, err
+		}
+		if err := uc.userRepo.UpdatePassword(ctx, user.ID, user.Password); err != nil {
+			return nil, errors.Wrap(err, "failed to rehash password")
+		}
 	}
 
 	return user, nil
 }
 
+// verifyDummyPassword 以假的雜湊值執行一次與真實帳號相同成本的驗證，結果一律捨棄
+func (uc *authUseCase) verifyDummyPassword(hashedPassword string) error {
+	dummy, err := uc.dummyPasswordHash()
+	if err != nil {
+		return errors.Wrap(err, "failed to hash dummy password")
+	}
+	if _, _, err := uc.passwords.Verify(hashedPassword, dummy); err != nil {
+		return errors.Wrap(err, "failed to verify password")
+	}
+
+	return nil
+}
+
+// hashPassword 以目前設定的演算法雜湊使用者的明文密碼
+func (uc *authUseCase) hashPassword(user *entity.User) error {
+	hashed, err := uc.passwords.Hash(user.Password)
+	if err != nil {
+		return errors.Wrap(err, "failed to hash password")
+	}
+	user.Password = hashed
+
+	return nil
+}
+
+// loginFailed 記錄登入失敗，若此次失敗觸發封鎖則回傳 LoginThrottledError
+func (uc *authUseCase) loginFailed(ctx context.Context, subjects []loginSubject) error {
+	retryAfter, err := uc.recordLoginFailure(ctx, subjects)
+	if err != nil {
+		return err
+	}
+	if retryAfter > 0 {
+		return &entity.LoginThrottledError{RetryAfter: retryAfter}
+	}
+
+	return errInvalidCredentials
+}
+
 func (uc *authUseCase) GetUserByID(ctx context.Context, userID string) (*entity.User, error) {
 	user, err := uc.userRepo.FindByID(ctx, userID)
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil, errs.Wrap(err, errs.KindNotFound, "user not found")
+	}
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to find user by ID")
 	}
 
 	return user, nil
 }
+
+// checkUserStatus 檢查使用者是否可以登入
+func checkUserStatus(user *entity.User) error {
+	switch user.Status {
+	case userstatus.UserStatusActive:
+		return nil
+	case userstatus.UserStatusPendingVerification:
+		return errs.New(errs.KindPermissionDenied, "email address has not been verified")
+	case userstatus.UserStatusSuspended:
+		return errs.New(errs.KindPermissionDenied, "account has been suspended")
+	default:
+		// 已刪除的帳號視同不存在
+		return errInvalidCredentials
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"server-template/internal/domain/entity"
 	"server-template/internal/domain/usecase"
 	"server-template/proto/pb/authpb"
+	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
@@ -31,26 +32,40 @@ func ProvideAuthHTTPUseCaseProxy(enableTracing bool, base usecase.AuthHTTPUseCas
 	return newAuthHTTPUseCaseProxy(base)
 }
 
-func (p *AuthHTTPUseCaseProxy) Register(ctx context.Context, email string, password string) (string, *entity.User, error) {
+func (p *AuthHTTPUseCaseProxy) Register(ctx context.Context, email string, password string, name string) (*entity.User, error) {
 	tracer := otel.Tracer("auth-http-usecase-tracer")
 	ctx, span := tracer.Start(ctx, "Register")
 	defer span.End()
 
-	ret0, ret1, err := p.AuthHTTPUseCase.Register(ctx, email, password)
+	ret0, err := p.AuthHTTPUseCase.Register(ctx, email, password, name)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 	}
 
-	return ret0, ret1, err
+	return ret0, err
 }
 
-func (p *AuthHTTPUseCaseProxy) Login(ctx context.Context, email string, password string) (string, *entity.User, error) {
+func (p *AuthHTTPUseCaseProxy) Login(ctx context.Context, email string, password string, client entity.ClientInfo) (*entity.LoginResult, error) {
 	tracer := otel.Tracer("auth-http-usecase-tracer")
 	ctx, span := tracer.Start(ctx, "Login")
 	defer span.End()
 
-	ret0, ret1, err := p.AuthHTTPUseCase.Login(ctx, email, password)
+	ret0, err := p.AuthHTTPUseCase.Login(ctx, email, password, client)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AuthHTTPUseCaseProxy) StartOIDCLogin(ctx context.Context, provider string) (string, string, error) {
+	tracer := otel.Tracer("auth-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "StartOIDCLogin")
+	defer span.End()
+
+	ret0, ret1, err := p.AuthHTTPUseCase.StartOIDCLogin(ctx, provider)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
@@ -59,12 +74,26 @@ func (p *AuthHTTPUseCaseProxy) Login(ctx context.Context, email string, password
 	return ret0, ret1, err
 }
 
-func (p *AuthHTTPUseCaseProxy) Logout(ctx context.Context, token string) (error) {
+func (p *AuthHTTPUseCaseProxy) CompleteOIDCLogin(ctx context.Context, provider string, state string, code string, client entity.ClientInfo) (*entity.LoginResult, error) {
+	tracer := otel.Tracer("auth-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "CompleteOIDCLogin")
+	defer span.End()
+
+	ret0, err := p.AuthHTTPUseCase.CompleteOIDCLogin(ctx, provider, state, code, client)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AuthHTTPUseCaseProxy) Logout(ctx context.Context, token string, refreshToken string) (error) {
 	tracer := otel.Tracer("auth-http-usecase-tracer")
 	ctx, span := tracer.Start(ctx, "Logout")
 	defer span.End()
 
-	err := p.AuthHTTPUseCase.Logout(ctx, token)
+	err := p.AuthHTTPUseCase.Logout(ctx, token, refreshToken)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
@@ -86,3 +115,367 @@ func (p *AuthHTTPUseCaseProxy) ValidateToken(ctx context.Context, token string)
 
 	return ret0, err
 }
+
+func (p *AuthHTTPUseCaseProxy) RefreshToken(ctx context.Context, refreshToken string) (*entity.TokenPair, error) {
+	tracer := otel.Tracer("auth-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "RefreshToken")
+	defer span.End()
+
+	ret0, err := p.AuthHTTPUseCase.RefreshToken(ctx, refreshToken)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AuthHTTPUseCaseProxy) ListSessions(ctx context.Context, authorization string, userID string) ([]*entity.Session, error) {
+	tracer := otel.Tracer("auth-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "ListSessions")
+	defer span.End()
+
+	ret0, err := p.AuthHTTPUseCase.ListSessions(ctx, authorization, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AuthHTTPUseCaseProxy) RevokeSession(ctx context.Context, authorization string, userID string, sessionID string) (error) {
+	tracer := otel.Tracer("auth-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "RevokeSession")
+	defer span.End()
+
+	err := p.AuthHTTPUseCase.RevokeSession(ctx, authorization, userID, sessionID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *AuthHTTPUseCaseProxy) RevokeAllSessions(ctx context.Context, authorization string, userID string, exceptSessionID string) (int, error) {
+	tracer := otel.Tracer("auth-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "RevokeAllSessions")
+	defer span.End()
+
+	ret0, err := p.AuthHTTPUseCase.RevokeAllSessions(ctx, authorization, userID, exceptSessionID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AuthHTTPUseCaseProxy) RequestPasswordReset(ctx context.Context, email string) (error) {
+	tracer := otel.Tracer("auth-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "RequestPasswordReset")
+	defer span.End()
+
+	err := p.AuthHTTPUseCase.RequestPasswordReset(ctx, email)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *AuthHTTPUseCaseProxy) ResetPassword(ctx context.Context, token string, newPassword string) (error) {
+	tracer := otel.Tracer("auth-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "ResetPassword")
+	defer span.End()
+
+	err := p.AuthHTTPUseCase.ResetPassword(ctx, token, newPassword)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *AuthHTTPUseCaseProxy) GetProfile(ctx context.Context, authorization string, userID string) (*entity.User, error) {
+	tracer := otel.Tracer("auth-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "GetProfile")
+	defer span.End()
+
+	ret0, err := p.AuthHTTPUseCase.GetProfile(ctx, authorization, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AuthHTTPUseCaseProxy) UpdateProfile(ctx context.Context, authorization string, userID string, name *string, email *string) (*entity.User, error) {
+	tracer := otel.Tracer("auth-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "UpdateProfile")
+	defer span.End()
+
+	ret0, err := p.AuthHTTPUseCase.UpdateProfile(ctx, authorization, userID, name, email)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AuthHTTPUseCaseProxy) ChangePassword(ctx context.Context, authorization string, userID string, currentPassword string, newPassword string) (int, error) {
+	tracer := otel.Tracer("auth-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "ChangePassword")
+	defer span.End()
+
+	ret0, err := p.AuthHTTPUseCase.ChangePassword(ctx, authorization, userID, currentPassword, newPassword)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AuthHTTPUseCaseProxy) VerifyEmail(ctx context.Context, token string) (*entity.User, error) {
+	tracer := otel.Tracer("auth-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "VerifyEmail")
+	defer span.End()
+
+	ret0, err := p.AuthHTTPUseCase.VerifyEmail(ctx, token)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AuthHTTPUseCaseProxy) ResendVerification(ctx context.Context, email string) (error) {
+	tracer := otel.Tracer("auth-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "ResendVerification")
+	defer span.End()
+
+	err := p.AuthHTTPUseCase.ResendVerification(ctx, email)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *AuthHTTPUseCaseProxy) VerifyMFA(ctx context.Context, challengeID string, code string, client entity.ClientInfo) (*entity.TokenPair, *entity.User, error) {
+	tracer := otel.Tracer("auth-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "VerifyMFA")
+	defer span.End()
+
+	ret0, ret1, err := p.AuthHTTPUseCase.VerifyMFA(ctx, challengeID, code, client)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, ret1, err
+}
+
+func (p *AuthHTTPUseCaseProxy) EnrollMFA(ctx context.Context, authorization string, userID string) (*entity.MFAEnrollment, error) {
+	tracer := otel.Tracer("auth-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "EnrollMFA")
+	defer span.End()
+
+	ret0, err := p.AuthHTTPUseCase.EnrollMFA(ctx, authorization, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AuthHTTPUseCaseProxy) ConfirmMFA(ctx context.Context, authorization string, userID string, code string) ([]string, error) {
+	tracer := otel.Tracer("auth-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "ConfirmMFA")
+	defer span.End()
+
+	ret0, err := p.AuthHTTPUseCase.ConfirmMFA(ctx, authorization, userID, code)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AuthHTTPUseCaseProxy) DisableMFA(ctx context.Context, authorization string, userID string, code string) (error) {
+	tracer := otel.Tracer("auth-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "DisableMFA")
+	defer span.End()
+
+	err := p.AuthHTTPUseCase.DisableMFA(ctx, authorization, userID, code)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *AuthHTTPUseCaseProxy) GrantRole(ctx context.Context, authorization string, userID string, role string) (error) {
+	tracer := otel.Tracer("auth-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "GrantRole")
+	defer span.End()
+
+	err := p.AuthHTTPUseCase.GrantRole(ctx, authorization, userID, role)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *AuthHTTPUseCaseProxy) RevokeRole(ctx context.Context, authorization string, userID string, role string) (error) {
+	tracer := otel.Tracer("auth-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "RevokeRole")
+	defer span.End()
+
+	err := p.AuthHTTPUseCase.RevokeRole(ctx, authorization, userID, role)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *AuthHTTPUseCaseProxy) CreateAPIKey(ctx context.Context, authorization string, userID string, name string, scopes []string, expiresAt *time.Time) (*entity.APIKey, string, error) {
+	tracer := otel.Tracer("auth-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "CreateAPIKey")
+	defer span.End()
+
+	ret0, ret1, err := p.AuthHTTPUseCase.CreateAPIKey(ctx, authorization, userID, name, scopes, expiresAt)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, ret1, err
+}
+
+func (p *AuthHTTPUseCaseProxy) ListAPIKeys(ctx context.Context, authorization string, userID string) ([]*entity.APIKey, error) {
+	tracer := otel.Tracer("auth-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "ListAPIKeys")
+	defer span.End()
+
+	ret0, err := p.AuthHTTPUseCase.ListAPIKeys(ctx, authorization, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AuthHTTPUseCaseProxy) RevokeAPIKey(ctx context.Context, authorization string, userID string, keyID string) (error) {
+	tracer := otel.Tracer("auth-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "RevokeAPIKey")
+	defer span.End()
+
+	err := p.AuthHTTPUseCase.RevokeAPIKey(ctx, authorization, userID, keyID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *AuthHTTPUseCaseProxy) ValidateAPIKey(ctx context.Context, key string) (*authpb.ValidateAPIKeyResponse, error) {
+	tracer := otel.Tracer("auth-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "ValidateAPIKey")
+	defer span.End()
+
+	ret0, err := p.AuthHTTPUseCase.ValidateAPIKey(ctx, key)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AuthHTTPUseCaseProxy) CreateOAuthClient(ctx context.Context, authorization string, name string, scopes []string, grantTypes []string) (*entity.OAuthClient, string, error) {
+	tracer := otel.Tracer("auth-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "CreateOAuthClient")
+	defer span.End()
+
+	ret0, ret1, err := p.AuthHTTPUseCase.CreateOAuthClient(ctx, authorization, name, scopes, grantTypes)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, ret1, err
+}
+
+func (p *AuthHTTPUseCaseProxy) RevokeOAuthClient(ctx context.Context, authorization string, clientID string) (error) {
+	tracer := otel.Tracer("auth-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "RevokeOAuthClient")
+	defer span.End()
+
+	err := p.AuthHTTPUseCase.RevokeOAuthClient(ctx, authorization, clientID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (p *AuthHTTPUseCaseProxy) IssueOAuth2Token(ctx context.Context, req entity.OAuth2TokenRequest) (*entity.OAuth2Token, error) {
+	tracer := otel.Tracer("auth-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "IssueOAuth2Token")
+	defer span.End()
+
+	ret0, err := p.AuthHTTPUseCase.IssueOAuth2Token(ctx, req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AuthHTTPUseCaseProxy) IntrospectToken(ctx context.Context, client entity.ClientCredentials, token string, tokenTypeHint string) (*entity.TokenIntrospection, error) {
+	tracer := otel.Tracer("auth-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "IntrospectToken")
+	defer span.End()
+
+	ret0, err := p.AuthHTTPUseCase.IntrospectToken(ctx, client, token, tokenTypeHint)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return ret0, err
+}
+
+func (p *AuthHTTPUseCaseProxy) RevokeToken(ctx context.Context, client entity.ClientCredentials, token string, tokenTypeHint string) (error) {
+	tracer := otel.Tracer("auth-http-usecase-tracer")
+	ctx, span := tracer.Start(ctx, "RevokeToken")
+	defer span.End()
+
+	err := p.AuthHTTPUseCase.RevokeToken(ctx, client, token, tokenTypeHint)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
@@ -2,13 +2,20 @@ package usecase
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"server-template/internal/domain/entity"
+	userstatus "server-template/internal/domain/entity/user"
+	"server-template/internal/domain/errs"
 	"server-template/internal/domain/repository"
 	"server-template/internal/domain/usecase"
+	"server-template/internal/infrastructure/rpc"
 	"server-template/proto/pb/authpb"
 
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type authHTTPUseCase struct {
@@ -21,74 +28,108 @@ func NewAuthHTTPUseCase(authRPC repository.AuthRPCRepository) usecase.AuthHTTPUs
 	}
 }
 
-func (uc *authHTTPUseCase) Register(ctx context.Context, email, password string) (string, *entity.User, error) {
+func (uc *authHTTPUseCase) Register(ctx context.Context, email, password, name string) (*entity.User, error) {
 	// 創建 gRPC 請求
 	grpcReq := &authpb.RegisterRequest{}
 	grpcReq.SetEmail(email)
 	grpcReq.SetPassword(password)
+	grpcReq.SetName(name)
 
 	// 調用 gRPC 服務
 	resp, err := uc.authRPC.Register(ctx, grpcReq)
 	if err != nil {
-		return "", nil, errors.Wrap(err, "failed to register user")
+		return nil, errors.Wrap(err, "failed to register user")
 	}
 
 	// 檢查 gRPC 響應狀態
 	if resp.GetStatus().GetCode() != int32(0) {
-		return "", nil, errors.New(resp.GetStatus().GetMessage())
+		return nil, statusError(resp.GetStatus())
 	}
 
-	// 通過 gRPC 生成 token
-	tokenReq := &authpb.GenerateTokenRequest{}
-	tokenReq.SetUserId(resp.GetUser().GetId())
-	tokenReq.SetEmail(resp.GetUser().GetEmail())
+	// 新用戶需驗證 email 後才能登入，因此不發放 token
+	return newUser(resp.GetUser()), nil
+}
+
+func (uc *authHTTPUseCase) Login(ctx context.Context, email, password string, client entity.ClientInfo) (*entity.LoginResult, error) {
+	// 創建 gRPC 請求
+	grpcReq := &authpb.LoginRequest{}
+	grpcReq.SetEmail(email)
+	grpcReq.SetPassword(password)
+	grpcReq.SetUserAgent(client.UserAgent)
+	grpcReq.SetIpAddress(client.IPAddress)
 
-	tokenResp, err := uc.authRPC.GenerateToken(ctx, tokenReq)
+	// 調用 gRPC 服務
+	resp, err := uc.authRPC.Login(ctx, grpcReq)
 	if err != nil {
-		return "", nil, errors.Wrap(err, "failed to generate token")
+		return nil, errors.Wrap(err, "failed to login user")
 	}
 
-	// 創建用戶實體
-	user := &entity.User{
-		ID:        resp.GetUser().GetId(),
-		Email:     resp.GetUser().GetEmail(),
-		CreatedAt: resp.GetUser().GetCreatedAt().AsTime(),
+	return loginResult(resp)
+}
+
+func (uc *authHTTPUseCase) StartOIDCLogin(ctx context.Context, provider string) (string, string, error) {
+	// 創建 gRPC 請求
+	grpcReq := &authpb.StartOIDCLoginRequest{}
+	grpcReq.SetProvider(provider)
+
+	// 調用 gRPC 服務
+	resp, err := uc.authRPC.StartOIDCLogin(ctx, grpcReq)
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to start OIDC login")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return "", "", statusError(resp.GetStatus())
 	}
 
-	return tokenResp.GetToken(), user, nil
+	return resp.GetAuthorizationUrl(), resp.GetState(), nil
 }
 
-func (uc *authHTTPUseCase) Login(ctx context.Context, email, password string) (string, *entity.User, error) {
+func (uc *authHTTPUseCase) CompleteOIDCLogin(ctx context.Context, provider, state, code string, client entity.ClientInfo) (*entity.LoginResult, error) {
 	// 創建 gRPC 請求
-	grpcReq := &authpb.LoginRequest{}
-	grpcReq.SetEmail(email)
-	grpcReq.SetPassword(password)
+	grpcReq := &authpb.CompleteOIDCLoginRequest{}
+	grpcReq.SetProvider(provider)
+	grpcReq.SetState(state)
+	grpcReq.SetCode(code)
+	grpcReq.SetUserAgent(client.UserAgent)
+	grpcReq.SetIpAddress(client.IPAddress)
 
 	// 調用 gRPC 服務
-	resp, err := uc.authRPC.Login(ctx, grpcReq)
+	resp, err := uc.authRPC.CompleteOIDCLogin(ctx, grpcReq)
 	if err != nil {
-		return "", nil, errors.Wrap(err, "failed to login user")
+		return nil, errors.Wrap(err, "failed to complete OIDC login")
 	}
 
+	return loginResult(resp)
+}
+
+// loginResult 將登入回應轉換為登入結果，需通過 MFA 驗證時只會帶有 challenge ID
+func loginResult(resp *authpb.LoginResponse) (*entity.LoginResult, error) {
 	// 檢查 gRPC 響應狀態
 	if resp.GetStatus().GetCode() != int32(0) {
-		return "", nil, errors.New(resp.GetStatus().GetMessage())
+		return nil, statusError(resp.GetStatus())
 	}
 
-	// 創建用戶實體
-	user := &entity.User{
-		ID:        resp.GetUser().GetId(),
-		Email:     resp.GetUser().GetEmail(),
-		CreatedAt: resp.GetUser().GetCreatedAt().AsTime(),
+	// 需通過 MFA 驗證才會取得 token
+	if resp.GetMfaRequired() {
+		return &entity.LoginResult{MFAChallengeID: resp.GetMfaChallengeId()}, nil
 	}
 
-	return resp.GetToken(), user, nil
+	return &entity.LoginResult{
+		Tokens: &entity.TokenPair{
+			AccessToken:  resp.GetToken(),
+			RefreshToken: resp.GetRefreshToken(),
+		},
+		User: newUser(resp.GetUser()),
+	}, nil
 }
 
-func (uc *authHTTPUseCase) Logout(ctx context.Context, token string) error {
+func (uc *authHTTPUseCase) Logout(ctx context.Context, token, refreshToken string) error {
 	// 創建 gRPC 請求
 	grpcReq := &authpb.LogoutRequest{}
 	grpcReq.SetToken(token)
+	grpcReq.SetRefreshToken(refreshToken)
 
 	// 調用 gRPC 服務
 	resp, err := uc.authRPC.Logout(ctx, grpcReq)
@@ -98,7 +139,7 @@ func (uc *authHTTPUseCase) Logout(ctx context.Context, token string) error {
 
 	// 檢查 gRPC 響應狀態
 	if resp.GetStatus().GetCode() != int32(0) {
-		return errors.New(resp.GetStatus().GetMessage())
+		return statusError(resp.GetStatus())
 	}
 
 	return nil
@@ -117,3 +158,635 @@ func (uc *authHTTPUseCase) ValidateToken(ctx context.Context, token string) (*au
 
 	return resp, nil
 }
+
+func (uc *authHTTPUseCase) RefreshToken(ctx context.Context, refreshToken string) (*entity.TokenPair, error) {
+	// 創建 gRPC 請求
+	grpcReq := &authpb.RefreshTokenRequest{}
+	grpcReq.SetRefreshToken(refreshToken)
+
+	// 調用 gRPC 服務
+	resp, err := uc.authRPC.RefreshToken(ctx, grpcReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to refresh token")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return nil, statusError(resp.GetStatus())
+	}
+
+	return &entity.TokenPair{
+		AccessToken:  resp.GetToken(),
+		RefreshToken: resp.GetRefreshToken(),
+	}, nil
+}
+
+func (uc *authHTTPUseCase) ListSessions(ctx context.Context, authorization, userID string) ([]*entity.Session, error) {
+	// 創建 gRPC 請求
+	grpcReq := &authpb.ListSessionsRequest{}
+	grpcReq.SetUserId(userID)
+
+	// 調用 gRPC 服務
+	resp, err := uc.authRPC.ListSessions(rpc.WithAuthorization(ctx, authorization), grpcReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list sessions")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return nil, statusError(resp.GetStatus())
+	}
+
+	sessions := make([]*entity.Session, 0, len(resp.GetSessions()))
+	for _, session := range resp.GetSessions() {
+		sessions = append(sessions, &entity.Session{
+			ID:        session.GetId(),
+			UserID:    userID,
+			UserAgent: session.GetUserAgent(),
+			IPAddress: session.GetIpAddress(),
+			IssuedAt:  session.GetIssuedAt().AsTime(),
+			ExpiresAt: session.GetExpiresAt().AsTime(),
+		})
+	}
+
+	return sessions, nil
+}
+
+func (uc *authHTTPUseCase) RevokeSession(ctx context.Context, authorization, userID, sessionID string) error {
+	// 創建 gRPC 請求
+	grpcReq := &authpb.RevokeSessionRequest{}
+	grpcReq.SetUserId(userID)
+	grpcReq.SetSessionId(sessionID)
+
+	// 調用 gRPC 服務
+	resp, err := uc.authRPC.RevokeSession(rpc.WithAuthorization(ctx, authorization), grpcReq)
+	if err != nil {
+		return errors.Wrap(err, "failed to revoke session")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return statusError(resp.GetStatus())
+	}
+
+	return nil
+}
+
+func (uc *authHTTPUseCase) RevokeAllSessions(ctx context.Context, authorization, userID, exceptSessionID string) (int, error) {
+	// 創建 gRPC 請求
+	grpcReq := &authpb.RevokeAllSessionsRequest{}
+	grpcReq.SetUserId(userID)
+	grpcReq.SetExceptSessionId(exceptSessionID)
+
+	// 調用 gRPC 服務
+	resp, err := uc.authRPC.RevokeAllSessions(rpc.WithAuthorization(ctx, authorization), grpcReq)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to revoke all sessions")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return 0, statusError(resp.GetStatus())
+	}
+
+	return int(resp.GetRevokedCount()), nil
+}
+
+func (uc *authHTTPUseCase) RequestPasswordReset(ctx context.Context, email string) error {
+	// 創建 gRPC 請求
+	grpcReq := &authpb.RequestPasswordResetRequest{}
+	grpcReq.SetEmail(email)
+
+	// 調用 gRPC 服務
+	resp, err := uc.authRPC.RequestPasswordReset(ctx, grpcReq)
+	if err != nil {
+		return errors.Wrap(err, "failed to request password reset")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return statusError(resp.GetStatus())
+	}
+
+	return nil
+}
+
+func (uc *authHTTPUseCase) ResetPassword(ctx context.Context, token, newPassword string) error {
+	// 創建 gRPC 請求
+	grpcReq := &authpb.ResetPasswordRequest{}
+	grpcReq.SetToken(token)
+	grpcReq.SetNewPassword(newPassword)
+
+	// 調用 gRPC 服務
+	resp, err := uc.authRPC.ResetPassword(ctx, grpcReq)
+	if err != nil {
+		return errors.Wrap(err, "failed to reset password")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return statusError(resp.GetStatus())
+	}
+
+	return nil
+}
+
+func (uc *authHTTPUseCase) GetProfile(ctx context.Context, authorization, userID string) (*entity.User, error) {
+	// 創建 gRPC 請求
+	grpcReq := &authpb.GetProfileRequest{}
+	grpcReq.SetUserId(userID)
+
+	// 調用 gRPC 服務
+	resp, err := uc.authRPC.GetProfile(rpc.WithAuthorization(ctx, authorization), grpcReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get profile")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return nil, statusError(resp.GetStatus())
+	}
+
+	return newUser(resp.GetUser()), nil
+}
+
+func (uc *authHTTPUseCase) UpdateProfile(ctx context.Context, authorization, userID string, name, email *string) (*entity.User, error) {
+	// 創建 gRPC 請求，未提供的欄位不設定
+	grpcReq := &authpb.UpdateProfileRequest{}
+	grpcReq.SetUserId(userID)
+	if name != nil {
+		grpcReq.SetName(*name)
+	}
+	if email != nil {
+		grpcReq.SetEmail(*email)
+	}
+
+	// 調用 gRPC 服務
+	resp, err := uc.authRPC.UpdateProfile(rpc.WithAuthorization(ctx, authorization), grpcReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update profile")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return nil, statusError(resp.GetStatus())
+	}
+
+	return newUser(resp.GetUser()), nil
+}
+
+func (uc *authHTTPUseCase) ChangePassword(ctx context.Context, authorization, userID, currentPassword, newPassword string) (int, error) {
+	// 創建 gRPC 請求，保留的工作階段由 Auth 服務依呼叫者的 token 決定
+	grpcReq := &authpb.ChangePasswordRequest{}
+	grpcReq.SetUserId(userID)
+	grpcReq.SetCurrentPassword(currentPassword)
+	grpcReq.SetNewPassword(newPassword)
+
+	// 調用 gRPC 服務
+	resp, err := uc.authRPC.ChangePassword(rpc.WithAuthorization(ctx, authorization), grpcReq)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to change password")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return 0, statusError(resp.GetStatus())
+	}
+
+	return int(resp.GetRevokedSessions()), nil
+}
+
+func (uc *authHTTPUseCase) VerifyEmail(ctx context.Context, token string) (*entity.User, error) {
+	// 創建 gRPC 請求
+	grpcReq := &authpb.VerifyEmailRequest{}
+	grpcReq.SetToken(token)
+
+	// 調用 gRPC 服務
+	resp, err := uc.authRPC.VerifyEmail(ctx, grpcReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to verify email")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return nil, statusError(resp.GetStatus())
+	}
+
+	return newUser(resp.GetUser()), nil
+}
+
+func (uc *authHTTPUseCase) ResendVerification(ctx context.Context, email string) error {
+	// 創建 gRPC 請求
+	grpcReq := &authpb.ResendVerificationRequest{}
+	grpcReq.SetEmail(email)
+
+	// 調用 gRPC 服務
+	resp, err := uc.authRPC.ResendVerification(ctx, grpcReq)
+	if err != nil {
+		return errors.Wrap(err, "failed to resend verification")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return statusError(resp.GetStatus())
+	}
+
+	return nil
+}
+
+func (uc *authHTTPUseCase) VerifyMFA(ctx context.Context, challengeID, code string, client entity.ClientInfo) (*entity.TokenPair, *entity.User, error) {
+	// 創建 gRPC 請求
+	grpcReq := &authpb.VerifyMFARequest{}
+	grpcReq.SetChallengeId(challengeID)
+	grpcReq.SetCode(code)
+	grpcReq.SetUserAgent(client.UserAgent)
+	grpcReq.SetIpAddress(client.IPAddress)
+
+	// 調用 gRPC 服務
+	resp, err := uc.authRPC.VerifyMFA(ctx, grpcReq)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to verify MFA")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return nil, nil, statusError(resp.GetStatus())
+	}
+
+	tokens := &entity.TokenPair{
+		AccessToken:  resp.GetToken(),
+		RefreshToken: resp.GetRefreshToken(),
+	}
+
+	return tokens, newUser(resp.GetUser()), nil
+}
+
+func (uc *authHTTPUseCase) EnrollMFA(ctx context.Context, authorization, userID string) (*entity.MFAEnrollment, error) {
+	// 創建 gRPC 請求
+	grpcReq := &authpb.EnrollMFARequest{}
+	grpcReq.SetUserId(userID)
+
+	// 調用 gRPC 服務
+	resp, err := uc.authRPC.EnrollMFA(rpc.WithAuthorization(ctx, authorization), grpcReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to enroll MFA")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return nil, statusError(resp.GetStatus())
+	}
+
+	return &entity.MFAEnrollment{
+		Secret: resp.GetSecret(),
+		URI:    resp.GetOtpauthUri(),
+	}, nil
+}
+
+func (uc *authHTTPUseCase) ConfirmMFA(ctx context.Context, authorization, userID, code string) ([]string, error) {
+	// 創建 gRPC 請求
+	grpcReq := &authpb.ConfirmMFARequest{}
+	grpcReq.SetUserId(userID)
+	grpcReq.SetCode(code)
+
+	// 調用 gRPC 服務
+	resp, err := uc.authRPC.ConfirmMFA(rpc.WithAuthorization(ctx, authorization), grpcReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to confirm MFA")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return nil, statusError(resp.GetStatus())
+	}
+
+	return resp.GetRecoveryCodes(), nil
+}
+
+func (uc *authHTTPUseCase) DisableMFA(ctx context.Context, authorization, userID, code string) error {
+	// 創建 gRPC 請求
+	grpcReq := &authpb.DisableMFARequest{}
+	grpcReq.SetUserId(userID)
+	grpcReq.SetCode(code)
+
+	// 調用 gRPC 服務
+	resp, err := uc.authRPC.DisableMFA(rpc.WithAuthorization(ctx, authorization), grpcReq)
+	if err != nil {
+		return errors.Wrap(err, "failed to disable MFA")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return statusError(resp.GetStatus())
+	}
+
+	return nil
+}
+
+func (uc *authHTTPUseCase) GrantRole(ctx context.Context, authorization, userID, role string) error {
+	// 創建 gRPC 請求
+	grpcReq := &authpb.GrantRoleRequest{}
+	grpcReq.SetUserId(userID)
+	grpcReq.SetRole(role)
+
+	// 以呼叫者的憑證調用 gRPC 服務，由 Auth 服務檢查權限
+	resp, err := uc.authRPC.GrantRole(rpc.WithAuthorization(ctx, authorization), grpcReq)
+	if err != nil {
+		return errors.Wrap(err, "failed to grant role")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return statusError(resp.GetStatus())
+	}
+
+	return nil
+}
+
+func (uc *authHTTPUseCase) RevokeRole(ctx context.Context, authorization, userID, role string) error {
+	// 創建 gRPC 請求
+	grpcReq := &authpb.RevokeRoleRequest{}
+	grpcReq.SetUserId(userID)
+	grpcReq.SetRole(role)
+
+	// 以呼叫者的憑證調用 gRPC 服務，由 Auth 服務檢查權限
+	resp, err := uc.authRPC.RevokeRole(rpc.WithAuthorization(ctx, authorization), grpcReq)
+	if err != nil {
+		return errors.Wrap(err, "failed to revoke role")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return statusError(resp.GetStatus())
+	}
+
+	return nil
+}
+
+func (uc *authHTTPUseCase) CreateAPIKey(ctx context.Context, authorization, userID, name string, scopes []string, expiresAt *time.Time) (*entity.APIKey, string, error) {
+	// 創建 gRPC 請求
+	grpcReq := &authpb.CreateAPIKeyRequest{}
+	grpcReq.SetUserId(userID)
+	grpcReq.SetName(name)
+	grpcReq.SetScopes(scopes)
+	if expiresAt != nil {
+		grpcReq.SetExpiresAt(timestamppb.New(*expiresAt))
+	}
+
+	// 調用 gRPC 服務
+	resp, err := uc.authRPC.CreateAPIKey(rpc.WithAuthorization(ctx, authorization), grpcReq)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to create API key")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return nil, "", statusError(resp.GetStatus())
+	}
+
+	return newAPIKey(resp.GetApiKey()), resp.GetKey(), nil
+}
+
+func (uc *authHTTPUseCase) ListAPIKeys(ctx context.Context, authorization, userID string) ([]*entity.APIKey, error) {
+	// 創建 gRPC 請求
+	grpcReq := &authpb.ListAPIKeysRequest{}
+	grpcReq.SetUserId(userID)
+
+	// 調用 gRPC 服務
+	resp, err := uc.authRPC.ListAPIKeys(rpc.WithAuthorization(ctx, authorization), grpcReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list API keys")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return nil, statusError(resp.GetStatus())
+	}
+
+	keys := make([]*entity.APIKey, 0, len(resp.GetApiKeys()))
+	for _, pbKey := range resp.GetApiKeys() {
+		keys = append(keys, newAPIKey(pbKey))
+	}
+
+	return keys, nil
+}
+
+func (uc *authHTTPUseCase) RevokeAPIKey(ctx context.Context, authorization, userID, keyID string) error {
+	// 創建 gRPC 請求
+	grpcReq := &authpb.RevokeAPIKeyRequest{}
+	grpcReq.SetUserId(userID)
+	grpcReq.SetId(keyID)
+
+	// 調用 gRPC 服務
+	resp, err := uc.authRPC.RevokeAPIKey(rpc.WithAuthorization(ctx, authorization), grpcReq)
+	if err != nil {
+		return errors.Wrap(err, "failed to revoke API key")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return statusError(resp.GetStatus())
+	}
+
+	return nil
+}
+
+func (uc *authHTTPUseCase) ValidateAPIKey(ctx context.Context, key string) (*authpb.ValidateAPIKeyResponse, error) {
+	// 創建 gRPC 請求
+	grpcReq := &authpb.ValidateAPIKeyRequest{}
+	grpcReq.SetKey(key)
+
+	// 調用 gRPC 服務
+	resp, err := uc.authRPC.ValidateAPIKey(ctx, grpcReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to validate API key")
+	}
+
+	return resp, nil
+}
+
+// statusError 將回應中非 OK 的狀態轉換為對應類型的領域錯誤
+func statusError(st *authpb.Status) error {
+	return errs.New(rpc.KindOf(codes.Code(st.GetCode())), st.GetMessage())
+}
+
+func (uc *authHTTPUseCase) CreateOAuthClient(ctx context.Context, authorization, name string, scopes, grantTypes []string) (*entity.OAuthClient, string, error) {
+	// 創建 gRPC 請求
+	grpcReq := &authpb.CreateOAuthClientRequest{}
+	grpcReq.SetName(name)
+	grpcReq.SetScopes(scopes)
+	grpcReq.SetGrantTypes(grantTypes)
+
+	// 以呼叫者的憑證調用 gRPC 服務，由 Auth 服務檢查權限
+	resp, err := uc.authRPC.CreateOAuthClient(rpc.WithAuthorization(ctx, authorization), grpcReq)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to create OAuth client")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return nil, "", statusError(resp.GetStatus())
+	}
+
+	return newOAuthClient(resp.GetClient()), resp.GetClientSecret(), nil
+}
+
+func (uc *authHTTPUseCase) RevokeOAuthClient(ctx context.Context, authorization, clientID string) error {
+	// 創建 gRPC 請求
+	grpcReq := &authpb.RevokeOAuthClientRequest{}
+	grpcReq.SetClientId(clientID)
+
+	// 以呼叫者的憑證調用 gRPC 服務，由 Auth 服務檢查權限
+	resp, err := uc.authRPC.RevokeOAuthClient(rpc.WithAuthorization(ctx, authorization), grpcReq)
+	if err != nil {
+		return errors.Wrap(err, "failed to revoke OAuth client")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return statusError(resp.GetStatus())
+	}
+
+	return nil
+}
+
+func (uc *authHTTPUseCase) IssueOAuth2Token(ctx context.Context, req entity.OAuth2TokenRequest) (*entity.OAuth2Token, error) {
+	// 創建 gRPC 請求
+	grpcReq := &authpb.IssueOAuth2TokenRequest{}
+	grpcReq.SetGrantType(req.GrantType)
+	grpcReq.SetClientId(req.Client.ClientID)
+	grpcReq.SetClientSecret(req.Client.ClientSecret)
+	grpcReq.SetScope(req.Scope)
+	grpcReq.SetRefreshToken(req.RefreshToken)
+
+	// 調用 gRPC 服務
+	resp, err := uc.authRPC.IssueOAuth2Token(ctx, grpcReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to issue OAuth2 token")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return nil, statusError(resp.GetStatus())
+	}
+
+	return &entity.OAuth2Token{
+		AccessToken:  resp.GetAccessToken(),
+		TokenType:    resp.GetTokenType(),
+		ExpiresIn:    time.Duration(resp.GetExpiresIn()) * time.Second,
+		RefreshToken: resp.GetRefreshToken(),
+		Scope:        resp.GetScope(),
+	}, nil
+}
+
+func (uc *authHTTPUseCase) IntrospectToken(ctx context.Context, client entity.ClientCredentials, token, tokenTypeHint string) (*entity.TokenIntrospection, error) {
+	// 創建 gRPC 請求
+	grpcReq := &authpb.IntrospectTokenRequest{}
+	grpcReq.SetClientId(client.ClientID)
+	grpcReq.SetClientSecret(client.ClientSecret)
+	grpcReq.SetToken(token)
+	grpcReq.SetTokenTypeHint(tokenTypeHint)
+
+	// 調用 gRPC 服務
+	resp, err := uc.authRPC.IntrospectToken(ctx, grpcReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to introspect token")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return nil, statusError(resp.GetStatus())
+	}
+
+	introspection := &entity.TokenIntrospection{
+		Active:    resp.GetActive(),
+		Scope:     resp.GetScope(),
+		ClientID:  resp.GetClientId(),
+		Username:  resp.GetUsername(),
+		Subject:   resp.GetSub(),
+		TokenType: resp.GetTokenType(),
+		TokenID:   resp.GetJti(),
+	}
+	if resp.HasExp() {
+		introspection.ExpiresAt = resp.GetExp().AsTime()
+	}
+	if resp.HasIat() {
+		introspection.IssuedAt = resp.GetIat().AsTime()
+	}
+
+	return introspection, nil
+}
+
+func (uc *authHTTPUseCase) RevokeToken(ctx context.Context, client entity.ClientCredentials, token, tokenTypeHint string) error {
+	// 創建 gRPC 請求
+	grpcReq := &authpb.RevokeTokenRequest{}
+	grpcReq.SetClientId(client.ClientID)
+	grpcReq.SetClientSecret(client.ClientSecret)
+	grpcReq.SetToken(token)
+	grpcReq.SetTokenTypeHint(tokenTypeHint)
+
+	// 調用 gRPC 服務
+	resp, err := uc.authRPC.RevokeToken(ctx, grpcReq)
+	if err != nil {
+		return errors.Wrap(err, "failed to revoke token")
+	}
+
+	// 檢查 gRPC 響應狀態
+	if resp.GetStatus().GetCode() != int32(0) {
+		return statusError(resp.GetStatus())
+	}
+
+	return nil
+}
+
+// newUser 將 protobuf 使用者訊息轉換為使用者實體
+func newUser(pbUser *authpb.User) *entity.User {
+	// 無法辨識的狀態保留為零值，不視為 active
+	status, _ := userstatus.ParseUserStatus(pbUser.GetStatus())
+
+	user := &entity.User{
+		ID:        pbUser.GetId(),
+		Name:      pbUser.GetName(),
+		Email:     pbUser.GetEmail(),
+		Status:    status,
+		CreatedAt: pbUser.GetCreatedAt().AsTime(),
+	}
+	if pbUser.HasPendingEmail() {
+		pendingEmail := pbUser.GetPendingEmail()
+		user.PendingEmail = &pendingEmail
+	}
+
+	return user
+}
+
+// newAPIKey 將 protobuf API key 訊息轉換為 API key 實體
+func newAPIKey(pbKey *authpb.APIKey) *entity.APIKey {
+	key := &entity.APIKey{
+		ID:        pbKey.GetId(),
+		Name:      pbKey.GetName(),
+		Prefix:    pbKey.GetPrefix(),
+		Scopes:    strings.Join(pbKey.GetScopes(), " "),
+		CreatedAt: pbKey.GetCreatedAt().AsTime(),
+	}
+	if pbKey.HasExpiresAt() {
+		expiresAt := pbKey.GetExpiresAt().AsTime()
+		key.ExpiresAt = &expiresAt
+	}
+	if pbKey.HasLastUsedAt() {
+		lastUsedAt := pbKey.GetLastUsedAt().AsTime()
+		key.LastUsedAt = &lastUsedAt
+	}
+
+	return key
+}
+
+// newOAuthClient 將 protobuf OAuth2 用戶端訊息轉換為用戶端實體
+func newOAuthClient(pbClient *authpb.OAuthClient) *entity.OAuthClient {
+	return &entity.OAuthClient{
+		ID:         pbClient.GetId(),
+		Name:       pbClient.GetName(),
+		Scopes:     strings.Join(pbClient.GetScopes(), " "),
+		GrantTypes: strings.Join(pbClient.GetGrantTypes(), " "),
+		CreatedAt:  pbClient.GetCreatedAt().AsTime(),
+	}
+}
@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"server-template/config"
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/errs"
+	"server-template/internal/domain/password"
+	"server-template/internal/domain/repository"
+)
+
+// countingHasher 記錄 Verify 的呼叫次數與比對的雜湊值
+type countingHasher struct {
+	password.Hasher
+
+	verified []string
+}
+
+func (h *countingHasher) Hash(password string) (string, error) {
+	return "hashed:" + password, nil
+}
+
+func (h *countingHasher) Verify(password, encoded string) (bool, bool, error) {
+	h.verified = append(h.verified, encoded)
+
+	return encoded == "hashed:"+password, false, nil
+}
+
+// fakeLoginAttemptRepository 不封鎖任何對象
+type fakeLoginAttemptRepository struct {
+	repository.LoginAttemptRepository
+}
+
+func (fakeLoginAttemptRepository) RecordFailure(context.Context, string, time.Duration) (int, error) {
+	return 1, nil
+}
+
+func (fakeLoginAttemptRepository) BlockedFor(context.Context, string) (time.Duration, error) {
+	return 0, nil
+}
+
+type discardRecorder struct{}
+
+func (discardRecorder) Record(context.Context, *entity.AuditEvent) {}
+
+func TestLoginVerifiesDummyHashForUnknownEmail(t *testing.T) {
+	hasher := &countingHasher{}
+	uc := NewAuthUseCase(
+		new(config.Config),
+		&fakeUserRepository{users: make(map[string]*entity.User)},
+		nil, nil, nil,
+		fakeLoginAttemptRepository{},
+		nil, nil, nil, nil, nil, nil,
+		hasher,
+		nil, nil,
+		discardRecorder{},
+	)
+
+	_, err := uc.Login(context.Background(), "nobody@example.com", "password", entity.ClientInfo{})
+	if errs.KindOf(err) != errs.KindInvalidCredentials {
+		t.Fatalf("Login error = %v, want invalid credentials", err)
+	}
+	if len(hasher.verified) != 1 {
+		t.Fatalf("Verify called %d times, want once against the dummy hash", len(hasher.verified))
+	}
+}
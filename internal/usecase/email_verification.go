@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"server-template/internal/domain/entity"
+	userstatus "server-template/internal/domain/entity/user"
+	"server-template/internal/domain/errs"
+	"server-template/internal/domain/notification"
+
+	"github.com/pkg/errors"
+)
+
+const defaultEmailVerificationTTL = 24 * time.Hour
+
+var errVerificationTokenInvalid = errs.FieldError("token", errors.New("verification token is invalid or has expired"))
+
+// VerifyEmail 以 email 驗證 token 啟用待驗證的使用者，token 使用後即失效。
+// 兩種 token 共用同一個驗證頁面，非註冊驗證的 token 會再以 email 變更 token 處理。
+func (uc *authUseCase) VerifyEmail(ctx context.Context, token string) (*entity.User, error) {
+	userID, err := uc.oneTimeTokens.Consume(ctx, entity.OneTimeTokenEmailVerification, hashOneTimeToken(token))
+	if errors.Is(err, errs.ErrNotFound) {
+		return uc.confirmEmailChange(ctx, token)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to consume verification token")
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find user by ID")
+	}
+
+	if user.Status != userstatus.UserStatusPendingVerification {
+		return nil, errVerificationTokenInvalid
+	}
+
+	if err := user.TransitionTo(userstatus.UserStatusActive); err != nil {
+		return nil, errors.Wrap(err, "failed to activate user")
+	}
+
+	if err := uc.userRepo.UpdateStatus(ctx, user.ID, user.Status); err != nil {
+		return nil, errors.Wrap(err, "failed to update user status")
+	}
+
+	return user, nil
+}
+
+// ResendVerification 重新寄送 email 驗證 token，先前的 token 隨即失效。
+// 為避免洩漏帳號是否存在，email 不存在或已驗證時同樣回傳成功。
+func (uc *authUseCase) ResendVerification(ctx context.Context, email string) error {
+	user, err := uc.userRepo.FindByEmail(ctx, email)
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to find user")
+	}
+
+	if user.Status != userstatus.UserStatusPendingVerification {
+		return nil
+	}
+
+	return uc.sendVerification(ctx, user)
+}
+
+// sendVerification 生成 email 驗證 token 並寄送給使用者
+func (uc *authUseCase) sendVerification(ctx context.Context, user *entity.User) error {
+	ttl := uc.cfg.Auth.EmailVerification.TTL
+	if ttl <= 0 {
+		ttl = defaultEmailVerificationTTL
+	}
+
+	token, err := uc.issueOneTimeToken(ctx, entity.OneTimeTokenEmailVerification, user.ID, ttl)
+	if err != nil {
+		return err
+	}
+
+	msg := &notification.Message{
+		To:      user.Email,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf(
+			"Use the following link to verify your email address. It expires in %s.\n\n%s",
+			ttl, oneTimeTokenLink(uc.cfg.Auth.EmailVerification.URL, token),
+		),
+	}
+	if err := uc.notifier.Send(ctx, msg); err != nil {
+		return errors.Wrap(err, "failed to send verification notification")
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"server-template/internal/domain/entity"
+	userstatus "server-template/internal/domain/entity/user"
+
+	"github.com/pkg/errors"
+)
+
+// erasableTokenPurposes 為抹除使用者時需一併作廢的一次性 token 用途
+var erasableTokenPurposes = []entity.OneTimeTokenPurpose{
+	entity.OneTimeTokenPasswordReset,
+	entity.OneTimeTokenEmailVerification,
+	entity.OneTimeTokenEmailChange,
+}
+
+// ExportUser 收集使用者的個人資料、角色、API key、外部身分與工作階段，供 GDPR 資料匯出
+func (uc *adminUseCase) ExportUser(ctx context.Context, userID string) (*entity.UserExport, error) {
+	user, err := uc.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := uc.roleRepo.FindByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find user roles")
+	}
+	roleNames := make([]string, 0, len(roles))
+	for _, role := range roles {
+		roleNames = append(roleNames, role.Name)
+	}
+
+	keys, err := uc.apiKeys.ListByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list API keys")
+	}
+
+	identities, err := uc.userIdentities.ListByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list user identities")
+	}
+
+	sessions, err := uc.sessions.ListByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list sessions")
+	}
+
+	auditEvents, err := uc.auditEvents.ListByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list audit events")
+	}
+
+	return &entity.UserExport{
+		ExportedAt:  time.Now().UTC(),
+		User:        user,
+		MFAEnabled:  user.MFAEnabled(),
+		Roles:       roleNames,
+		APIKeys:     keys,
+		Identities:  identities,
+		Sessions:    sessions,
+		AuditEvents: auditEvents,
+	}, nil
+}
+
+// EraseUser 依 GDPR 刪除權匿名化使用者：以不含個人資料的 email 取代原 email、清除名稱與密碼並標記為已刪除，
+// 同時刪除其 MFA 設定、外部身分、角色與 API key，並作廢尚未使用的一次性 token。
+// 使用者的工作階段與 token 記錄存於 token 服務，需由呼叫端另行結束並刪除。
+func (uc *adminUseCase) EraseUser(ctx context.Context, userID string) (*entity.User, error) {
+	user, err := uc.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 已刪除但尚未匿名化的使用者同樣可以抹除
+	if user.Status != userstatus.UserStatusDeleted {
+		if err := user.TransitionTo(userstatus.UserStatusDeleted); err != nil {
+			return nil, errors.Wrap(err, "failed to delete user")
+		}
+	}
+	user.Name = ""
+	user.Email = entity.ErasedEmail(user.ID)
+	user.PendingEmail = nil
+	user.Password = ""
+	user.MFA = nil
+
+	if err := uc.userRepo.Erase(ctx, user); err != nil {
+		return nil, errors.Wrap(err, "failed to erase user")
+	}
+
+	for _, purpose := range erasableTokenPurposes {
+		if err := uc.oneTimeTokens.Revoke(ctx, purpose, user.ID); err != nil {
+			return nil, errors.Wrap(err, "failed to revoke one-time tokens")
+		}
+	}
+
+	uc.recordUserEvent(ctx, entity.AuditEventUserErase, user.ID)
+
+	return user, nil
+}
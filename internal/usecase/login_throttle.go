@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"server-template/internal/domain/entity"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultLoginFailureWindow   = time.Hour
+	defaultLoginFreeAttempts    = 3
+	defaultLoginBaseDelay       = time.Second
+	defaultLoginMaxDelay        = 5 * time.Minute
+	defaultMaxEmailFailures     = 10
+	defaultMaxIPFailures        = 50
+	defaultLoginLockoutDuration = 30 * time.Minute
+)
+
+// loginSubject 為登入失敗的統計對象，帳號與 IP 分別計算
+type loginSubject struct {
+	key         string
+	maxFailures int
+}
+
+func (uc *authUseCase) loginSubjects(email, ipAddress string) []loginSubject {
+	cfg := uc.cfg.Auth.BruteForce
+
+	subjects := []loginSubject{
+		{key: emailLoginSubject(email), maxFailures: orDefault(cfg.MaxEmailFailures, defaultMaxEmailFailures)},
+	}
+	if ipAddress != "" {
+		subjects = append(subjects, loginSubject{
+			key:         "ip:" + ipAddress,
+			maxFailures: orDefault(cfg.MaxIPFailures, defaultMaxIPFailures),
+		})
+	}
+
+	return subjects
+}
+
+// checkLoginThrottle 檢查是否有任一對象仍在封鎖中
+func (uc *authUseCase) checkLoginThrottle(ctx context.Context, subjects []loginSubject) error {
+	var retryAfter time.Duration
+	for _, subject := range subjects {
+		blockedFor, err := uc.loginAttempts.BlockedFor(ctx, subject.key)
+		if err != nil {
+			return errors.Wrap(err, "failed to check login throttle")
+		}
+		retryAfter = max(retryAfter, blockedFor)
+	}
+
+	if retryAfter > 0 {
+		return &entity.LoginThrottledError{RetryAfter: retryAfter}
+	}
+
+	return nil
+}
+
+// recordLoginFailure 累計登入失敗次數並依次數封鎖後續嘗試，回傳本次造成的最長封鎖時間
+func (uc *authUseCase) recordLoginFailure(ctx context.Context, subjects []loginSubject) (time.Duration, error) {
+	cfg := uc.cfg.Auth.BruteForce
+	window := orDefault(cfg.FailureWindow, defaultLoginFailureWindow)
+
+	var longest time.Duration
+	for _, subject := range subjects {
+		failures, err := uc.loginAttempts.RecordFailure(ctx, subject.key, window)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to record login failure")
+		}
+
+		delay := uc.loginDelay(failures, subject.maxFailures)
+		if delay <= 0 {
+			continue
+		}
+
+		if err := uc.loginAttempts.Block(ctx, subject.key, delay); err != nil {
+			return 0, errors.Wrap(err, "failed to block login attempts")
+		}
+		longest = max(longest, delay)
+	}
+
+	return longest, nil
+}
+
+// loginDelay 計算失敗 failures 次後需等待的時間：
+// 前 FreeAttempts 次不延遲，之後自 BaseDelay 起每次加倍直到 MaxDelay，達到 maxFailures 時鎖定 LockoutDuration
+func (uc *authUseCase) loginDelay(failures, maxFailures int) time.Duration {
+	cfg := uc.cfg.Auth.BruteForce
+
+	if failures >= maxFailures {
+		return orDefault(cfg.LockoutDuration, defaultLoginLockoutDuration)
+	}
+
+	excess := failures - orDefault(cfg.FreeAttempts, defaultLoginFreeAttempts)
+	if excess <= 0 {
+		return 0
+	}
+
+	maxDelay := orDefault(cfg.MaxDelay, defaultLoginMaxDelay)
+	delay := orDefault(cfg.BaseDelay, defaultLoginBaseDelay)
+	for i := 1; i < excess && delay < maxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, maxDelay)
+}
+
+// UnlockAccount 清除帳號的登入失敗次數與鎖定，供管理員解鎖使用
+func (uc *authUseCase) UnlockAccount(ctx context.Context, email string) error {
+	if err := uc.loginAttempts.Reset(ctx, emailLoginSubject(email)); err != nil {
+		return errors.Wrap(err, "failed to unlock account")
+	}
+
+	return nil
+}
+
+func emailLoginSubject(email string) string {
+	return "email:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+func orDefault[T int | time.Duration](value, fallback T) T {
+	if value > 0 {
+		return value
+	}
+
+	return fallback
+}
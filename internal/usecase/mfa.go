@@ -0,0 +1,279 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"strings"
+	"time"
+
+	"server-template/internal/domain/audit"
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/errs"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/pquerna/otp/totp"
+)
+
+const (
+	defaultMFAChallengeTTL = 5 * time.Minute
+
+	// maxMFAAttempts 為每個 challenge 可嘗試的次數，超過後需重新以密碼登入；
+	// 失敗也計入帳號的登入失敗次數，重新登入取得的 challenge 不會重置封鎖
+	maxMFAAttempts = 5
+
+	recoveryCodeCount = 10
+	// recoveryCodeBytes 編碼後為 10 個 base32 字元，顯示為 xxxxx-xxxxx
+	recoveryCodeBytes = 6
+)
+
+var (
+	errMFAChallengeInvalid = errs.New(errs.KindInvalidCredentials, "MFA challenge is invalid or has expired")
+	errMFACodeInvalid      = errs.New(errs.KindInvalidCredentials, "invalid MFA code")
+	errMFAAlreadyEnabled   = errs.New(errs.KindFailedPrecondition, "MFA is already enabled")
+)
+
+// EnrollMFA 為使用者產生新的 TOTP secret，需以 ConfirmMFA 確認後才會啟用
+func (uc *authUseCase) EnrollMFA(ctx context.Context, userID string) (*entity.MFAEnrollment, error) {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find user by ID")
+	}
+
+	if user.MFAEnabled() {
+		return nil, errMFAAlreadyEnabled
+	}
+
+	issuer := uc.cfg.Auth.MFA.Issuer
+	if issuer == "" {
+		issuer = uc.cfg.Env.ServiceName
+	}
+
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      issuer,
+		AccountName: user.Email,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate TOTP secret")
+	}
+
+	if err := uc.mfaRepo.Save(ctx, &entity.MFASetting{UserID: user.ID, Secret: key.Secret()}); err != nil {
+		return nil, errors.Wrap(err, "failed to save MFA setting")
+	}
+
+	return &entity.MFAEnrollment{
+		Secret: key.Secret(),
+		URI:    key.URL(),
+	}, nil
+}
+
+// ConfirmMFA 以驗證器產生的驗證碼確認綁定並啟用 MFA，回傳只會顯示一次的復原碼
+func (uc *authUseCase) ConfirmMFA(ctx context.Context, userID, code string) ([]string, error) {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find user by ID")
+	}
+
+	if user.MFA == nil {
+		return nil, errs.New(errs.KindFailedPrecondition, "MFA enrollment has not been started")
+	}
+	if user.MFA.Enabled {
+		return nil, errMFAAlreadyEnabled
+	}
+
+	if !totp.Validate(code, user.MFA.Secret) {
+		return nil, errMFACodeInvalid
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.mfaRepo.Enable(ctx, user.ID, hashes); err != nil {
+		return nil, errors.Wrap(err, "failed to enable MFA")
+	}
+
+	return codes, nil
+}
+
+// DisableMFA 以 TOTP 驗證碼或復原碼停用 MFA，並刪除所有復原碼
+func (uc *authUseCase) DisableMFA(ctx context.Context, userID, code string) error {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return errors.Wrap(err, "failed to find user by ID")
+	}
+
+	if !user.MFAEnabled() {
+		return errs.New(errs.KindFailedPrecondition, "MFA is not enabled")
+	}
+
+	ok, err := uc.verifyMFACode(ctx, user, code)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errMFACodeInvalid
+	}
+
+	if err := uc.mfaRepo.Delete(ctx, user.ID); err != nil {
+		return errors.Wrap(err, "failed to disable MFA")
+	}
+
+	return nil
+}
+
+// CreateMFAChallenge 為已通過密碼驗證的使用者建立 MFA challenge，回傳 challenge ID
+func (uc *authUseCase) CreateMFAChallenge(ctx context.Context, userID string) (string, error) {
+	ttl := uc.cfg.Auth.MFA.ChallengeTTL
+	if ttl <= 0 {
+		ttl = defaultMFAChallengeTTL
+	}
+
+	challenge := &entity.MFAChallenge{
+		ID:        uuid.New().String(),
+		UserID:    userID,
+		ExpiresAt: time.Now().Add(ttl),
+	}
+	if err := uc.mfaChallenges.Save(ctx, challenge); err != nil {
+		return "", errors.Wrap(err, "failed to save MFA challenge")
+	}
+
+	return challenge.ID, nil
+}
+
+// VerifyMFA 以 TOTP 驗證碼或復原碼完成 MFA challenge，成功後 challenge 即失效
+func (uc *authUseCase) VerifyMFA(ctx context.Context, challengeID, code string) (*entity.User, error) {
+	challenge, err := uc.mfaChallenges.FindByID(ctx, challengeID)
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil, errMFAChallengeInvalid
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find MFA challenge")
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, challenge.UserID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find user by ID")
+	}
+
+	if err := checkUserStatus(user); err != nil {
+		return nil, err
+	}
+	if !user.MFAEnabled() {
+		return nil, errMFAChallengeInvalid
+	}
+
+	// 與密碼登入共用帳號與 IP 的失敗次數及封鎖
+	client := audit.ClientFromContext(ctx)
+	subjects := uc.loginSubjects(user.Email, client.IPAddress)
+	if err := uc.checkLoginThrottle(ctx, subjects); err != nil {
+		var throttled *entity.LoginThrottledError
+		if errors.As(err, &throttled) {
+			uc.recordLoginDenied(ctx, user.ID, client, throttled.Error())
+		}
+
+		return nil, err
+	}
+
+	ok, err := uc.verifyMFACode(ctx, user, code)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		uc.recordLoginDenied(ctx, user.ID, client, "invalid MFA code")
+
+		mfaErr := uc.recordMFAFailure(ctx, challengeID)
+		retryAfter, err := uc.recordLoginFailure(ctx, subjects)
+		if err != nil {
+			return nil, err
+		}
+		if retryAfter > 0 {
+			return nil, &entity.LoginThrottledError{RetryAfter: retryAfter}
+		}
+
+		return nil, mfaErr
+	}
+
+	// 刪除成功者才算通過驗證，避免同一個 challenge 被並行使用兩次
+	deleted, err := uc.mfaChallenges.Delete(ctx, challengeID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to delete MFA challenge")
+	}
+	if !deleted {
+		return nil, errMFAChallengeInvalid
+	}
+
+	if err := uc.loginAttempts.Reset(ctx, subjects[0].key); err != nil {
+		return nil, errors.Wrap(err, "failed to reset login failures")
+	}
+
+	return user, nil
+}
+
+// recordMFAFailure 累計 challenge 的失敗次數，達到上限時刪除 challenge
+func (uc *authUseCase) recordMFAFailure(ctx context.Context, challengeID string) error {
+	attempts, err := uc.mfaChallenges.RecordFailure(ctx, challengeID)
+	if errors.Is(err, errs.ErrNotFound) {
+		return errMFAChallengeInvalid
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to record MFA failure")
+	}
+
+	if attempts >= maxMFAAttempts {
+		if _, err := uc.mfaChallenges.Delete(ctx, challengeID); err != nil {
+			return errors.Wrap(err, "failed to delete MFA challenge")
+		}
+
+		return errs.New(errs.KindInvalidCredentials, "too many invalid MFA codes, please log in again")
+	}
+
+	return errMFACodeInvalid
+}
+
+// verifyMFACode 先以 TOTP 驗證，失敗時再嘗試作為復原碼使用
+func (uc *authUseCase) verifyMFACode(ctx context.Context, user *entity.User, code string) (bool, error) {
+	if totp.Validate(code, user.MFA.Secret) {
+		return true, nil
+	}
+
+	normalized := normalizeRecoveryCode(code)
+	if normalized == "" {
+		return false, nil
+	}
+
+	ok, err := uc.mfaRepo.ConsumeRecoveryCode(ctx, user.ID, hashOneTimeToken(normalized))
+	if err != nil {
+		return false, errors.Wrap(err, "failed to consume recovery code")
+	}
+
+	return ok, nil
+}
+
+// generateRecoveryCodes 產生復原碼，回傳顯示給使用者的復原碼與其雜湊值
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+
+	buf := make([]byte, recoveryCodeBytes)
+	for range recoveryCodeCount {
+		if _, err := rand.Read(buf); err != nil {
+			return nil, nil, errors.Wrap(err, "failed to generate recovery code")
+		}
+
+		code := strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(buf))
+		codes = append(codes, code[:5]+"-"+code[5:])
+		hashes = append(hashes, hashOneTimeToken(code))
+	}
+
+	return codes, hashes, nil
+}
+
+// normalizeRecoveryCode 移除使用者輸入中的分隔符號與空白並轉為小寫
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
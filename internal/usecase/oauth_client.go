@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/base64"
+	"slices"
+	"strings"
+
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/errs"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// oauthClientSecretBytes 為 client secret 的隨機位元組長度
+const oauthClientSecretBytes = 32
+
+var (
+	errOAuthClientInvalid = errs.New(errs.KindInvalidCredentials, "client authentication failed")
+
+	// supportedGrantTypes 為授權伺服器支援、可註冊給用戶端的 grant type
+	supportedGrantTypes = []string{entity.GrantTypeClientCredentials, entity.GrantTypeRefreshToken}
+)
+
+// CreateOAuthClient 註冊 OAuth2 用戶端，scopes 需為呼叫者 callerPermissions 中的權限，避免藉由用戶端取得自己沒有的權限。
+// 回傳的 client secret 只會出現這一次，之後僅存儲其雜湊值。
+func (uc *authUseCase) CreateOAuthClient(ctx context.Context, name string, scopes, grantTypes, callerPermissions []string) (*entity.OAuthClient, string, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, "", errs.FieldError("name", errors.New("name is required"))
+	}
+	if len(grantTypes) == 0 {
+		return nil, "", errs.FieldError("grant_types", errors.New("at least one grant type is required"))
+	}
+	for _, grantType := range grantTypes {
+		if !slices.Contains(supportedGrantTypes, grantType) {
+			return nil, "", errs.FieldError("grant_types", errors.Errorf("unsupported grant type %q", grantType))
+		}
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(callerPermissions, scope) {
+			return nil, "", errs.New(errs.KindPermissionDenied, "cannot grant permission "+scope+" that the caller does not have")
+		}
+	}
+
+	secret, err := randomAPIKeyPart(oauthClientSecretBytes, base64.RawURLEncoding)
+	if err != nil {
+		return nil, "", err
+	}
+
+	client := &entity.OAuthClient{
+		Name:       name,
+		SecretHash: hashOneTimeToken(secret),
+		Scopes:     strings.Join(slices.Compact(slices.Sorted(slices.Values(scopes))), " "),
+		GrantTypes: strings.Join(slices.Compact(slices.Sorted(slices.Values(grantTypes))), " "),
+	}
+	if err := uc.oauthClients.Create(ctx, client); err != nil {
+		return nil, "", errors.Wrap(err, "failed to create OAuth client")
+	}
+
+	return client, secret, nil
+}
+
+// RevokeOAuthClient 撤銷 OAuth2 用戶端，已簽發的 access token 仍有效至過期為止
+func (uc *authUseCase) RevokeOAuthClient(ctx context.Context, clientID string) error {
+	if err := uuid.Validate(clientID); err != nil {
+		return errs.New(errs.KindNotFound, "OAuth client not found")
+	}
+
+	revoked, err := uc.oauthClients.Revoke(ctx, clientID)
+	if err != nil {
+		return errors.Wrap(err, "failed to revoke OAuth client")
+	}
+	if !revoked {
+		return errs.New(errs.KindNotFound, "OAuth client not found")
+	}
+
+	return nil
+}
+
+// AuthenticateOAuthClient 以 client_id 與 client secret 驗證用戶端，
+// 用戶端不存在、secret 錯誤或已撤銷時回傳相同的錯誤
+func (uc *authUseCase) AuthenticateOAuthClient(ctx context.Context, credentials entity.ClientCredentials) (*entity.OAuthClient, error) {
+	if uuid.Validate(credentials.ClientID) != nil || credentials.ClientSecret == "" {
+		return nil, errOAuthClientInvalid
+	}
+
+	client, err := uc.oauthClients.FindByID(ctx, credentials.ClientID)
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil, errOAuthClientInvalid
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find OAuth client")
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashOneTimeToken(credentials.ClientSecret)), []byte(client.SecretHash)) != 1 || client.RevokedAt != nil {
+		return nil, errOAuthClientInvalid
+	}
+
+	return client, nil
+}
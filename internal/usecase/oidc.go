@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+	"unicode/utf8"
+
+	"server-template/internal/domain/entity"
+	userstatus "server-template/internal/domain/entity/user"
+	"server-template/internal/domain/errs"
+	"server-template/internal/domain/identity"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+)
+
+const (
+	defaultOIDCStateTTL = 10 * time.Minute
+
+	// oidcRandomBytes 為 state 與 nonce 的隨機位元組長度
+	oidcRandomBytes = 32
+	// maxUserNameLength 與 users.name 欄位長度相同
+	maxUserNameLength = 32
+)
+
+var errOIDCStateInvalid = errs.New(errs.KindInvalidCredentials, "login state is invalid or has expired")
+
+// StartOIDCLogin 產生導向外部身分提供者登入的網址與其 state，並暫存 state、nonce 與 PKCE verifier
+func (uc *authUseCase) StartOIDCLogin(ctx context.Context, providerName string) (string, string, error) {
+	provider, err := uc.identityProviders.Get(ctx, providerName)
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to get identity provider")
+	}
+
+	state, err := randomOIDCValue()
+	if err != nil {
+		return "", "", err
+	}
+	nonce, err := randomOIDCValue()
+	if err != nil {
+		return "", "", err
+	}
+
+	loginState := &entity.OIDCLoginState{
+		State:        state,
+		Provider:     providerName,
+		Nonce:        nonce,
+		CodeVerifier: oauth2.GenerateVerifier(),
+	}
+	ttl := orDefault(uc.cfg.Auth.OIDC.StateTTL, defaultOIDCStateTTL)
+	if err := uc.oidcStates.Save(ctx, loginState, ttl); err != nil {
+		return "", "", errors.Wrap(err, "failed to save OIDC state")
+	}
+
+	return provider.AuthCodeURL(state, nonce, loginState.CodeVerifier), state, nil
+}
+
+// CompleteOIDCLogin 以身分提供者回呼的授權碼完成登入，回傳連結的使用者。
+// 外部身分尚未連結時，依提供者驗證過的 email 連結至既有使用者或建立新使用者。
+func (uc *authUseCase) CompleteOIDCLogin(ctx context.Context, providerName, state, code string) (*entity.User, error) {
+	loginState, err := uc.oidcStates.Consume(ctx, state)
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil, errOIDCStateInvalid
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to consume OIDC state")
+	}
+	if loginState.Provider != providerName {
+		return nil, errOIDCStateInvalid
+	}
+
+	provider, err := uc.identityProviders.Get(ctx, providerName)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get identity provider")
+	}
+
+	ident, err := provider.Exchange(ctx, code, loginState.CodeVerifier, loginState.Nonce)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to verify identity")
+	}
+
+	user, err := uc.userForIdentity(ctx, providerName, ident)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkUserStatus(user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// userForIdentity 回傳外部身分連結的使用者，尚未連結時建立連結
+func (uc *authUseCase) userForIdentity(ctx context.Context, providerName string, ident *identity.Identity) (*entity.User, error) {
+	linked, err := uc.userIdentities.FindByProviderSubject(ctx, providerName, ident.Subject)
+	if err == nil {
+		if err := uc.userIdentities.TouchLastLogin(ctx, linked.ID, time.Now()); err != nil {
+			return nil, errors.Wrap(err, "failed to update identity last login")
+		}
+
+		user, err := uc.userRepo.FindByID(ctx, linked.UserID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find user by ID")
+		}
+
+		return user, nil
+	}
+	if !errors.Is(err, errs.ErrNotFound) {
+		return nil, errors.Wrap(err, "failed to find identity")
+	}
+
+	// 僅信任提供者驗證過的 email，避免他人以相同 email 的外部帳號接管既有帳號
+	if ident.Email == "" || !ident.EmailVerified {
+		return nil, errs.New(errs.KindPermissionDenied, "identity provider did not return a verified email address")
+	}
+
+	user, err := uc.userRepo.FindByEmail(ctx, ident.Email)
+	switch {
+	case errors.Is(err, errs.ErrNotFound):
+		user, err = uc.createOIDCUser(ctx, ident)
+		if err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, errors.Wrap(err, "failed to find user")
+	case user.Status == userstatus.UserStatusPendingVerification:
+		// 提供者已驗證 email，視同完成 email 驗證；帳號可能由他人搶先以此 email 註冊，
+		// 啟用前改為隨機密碼，使註冊時設定的密碼失效。未驗證的帳號無法登入，不會有需撤銷的工作階段
+		if err := uc.resetOIDCPassword(ctx, user); err != nil {
+			return nil, err
+		}
+		if err := user.TransitionTo(userstatus.UserStatusActive); err != nil {
+			return nil, errors.Wrap(err, "failed to activate user")
+		}
+		if err := uc.userRepo.UpdateStatus(ctx, user.ID, user.Status); err != nil {
+			return nil, errors.Wrap(err, "failed to update user status")
+		}
+	}
+
+	link := &entity.UserIdentity{
+		UserID:   user.ID,
+		Provider: providerName,
+		Subject:  ident.Subject,
+		Email:    ident.Email,
+	}
+	if err := uc.userIdentities.Create(ctx, link); err != nil {
+		return nil, errors.Wrap(err, "failed to link identity")
+	}
+
+	return user, nil
+}
+
+// createOIDCUser 為外部身分建立使用者，並設定隨機密碼，使用者可透過重設密碼流程改用密碼登入
+func (uc *authUseCase) createOIDCUser(ctx context.Context, ident *identity.Identity) (*entity.User, error) {
+	password, err := randomOIDCValue()
+	if err != nil {
+		return nil, err
+	}
+
+	user := &entity.User{
+		ID:       uuid.New().String(),
+		Name:     truncateRunes(ident.Name, maxUserNameLength),
+		Email:    ident.Email,
+		Password: password,
+		Status:   userstatus.UserStatusActive,
+	}
+	if err := uc.hashPassword(user); err != nil {
+		return nil, err
+	}
+
+	if err := uc.userRepo.Create(ctx, user); err != nil {
+		return nil, errors.Wrap(err, "failed to create user")
+	}
+
+	return user, nil
+}
+
+// resetOIDCPassword 將使用者密碼改為隨機值，使用者可透過重設密碼流程設定新密碼
+func (uc *authUseCase) resetOIDCPassword(ctx context.Context, user *entity.User) error {
+	password, err := randomOIDCValue()
+	if err != nil {
+		return err
+	}
+
+	user.Password = password
+	if err := uc.hashPassword(user); err != nil {
+		return err
+	}
+
+	return errors.Wrap(uc.userRepo.UpdatePassword(ctx, user.ID, user.Password), "failed to update password")
+}
+
+func randomOIDCValue() (string, error) {
+	buf := make([]byte, oidcRandomBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", errors.Wrap(err, "failed to generate random value")
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func truncateRunes(s string, limit int) string {
+	if utf8.RuneCountInString(s) <= limit {
+		return s
+	}
+
+	return string([]rune(s)[:limit])
+}
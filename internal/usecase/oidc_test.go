@@ -0,0 +1,397 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"server-template/config"
+	"server-template/internal/domain/entity"
+	userstatus "server-template/internal/domain/entity/user"
+	"server-template/internal/domain/errs"
+	"server-template/internal/domain/password"
+	"server-template/internal/domain/repository"
+	"server-template/internal/infrastructure/identity"
+	"server-template/internal/infrastructure/identity/identitytest"
+
+	"github.com/google/uuid"
+)
+
+const testProvider = "test"
+
+// fakeUserRepository 為記憶體中的使用者資料，只實作 OIDC 登入用到的方法
+type fakeUserRepository struct {
+	repository.UserRepository
+
+	mu    sync.Mutex
+	users map[string]*entity.User
+}
+
+func (r *fakeUserRepository) Create(_ context.Context, user *entity.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := *user
+	r.users[user.ID] = &stored
+
+	return nil
+}
+
+func (r *fakeUserRepository) FindByEmail(_ context.Context, email string) (*entity.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, user := range r.users {
+		if user.Email == email {
+			found := *user
+
+			return &found, nil
+		}
+	}
+
+	return nil, errs.ErrNotFound
+}
+
+func (r *fakeUserRepository) FindByID(_ context.Context, id string) (*entity.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	user, ok := r.users[id]
+	if !ok {
+		return nil, errs.ErrNotFound
+	}
+	found := *user
+
+	return &found, nil
+}
+
+func (r *fakeUserRepository) UpdateStatus(_ context.Context, id string, status userstatus.UserStatus) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.users[id].Status = status
+
+	return nil
+}
+
+func (r *fakeUserRepository) UpdatePassword(_ context.Context, id, hashedPassword string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.users[id].Password = hashedPassword
+
+	return nil
+}
+
+// fakeUserIdentityRepository 為記憶體中的外部身分連結
+type fakeUserIdentityRepository struct {
+	repository.UserIdentityRepository
+
+	mu         sync.Mutex
+	identities []*entity.UserIdentity
+}
+
+func (r *fakeUserIdentityRepository) Create(_ context.Context, identity *entity.UserIdentity) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	identity.ID = uuid.New().String()
+	r.identities = append(r.identities, identity)
+
+	return nil
+}
+
+func (r *fakeUserIdentityRepository) FindByProviderSubject(_ context.Context, provider, subject string) (*entity.UserIdentity, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, identity := range r.identities {
+		if identity.Provider == provider && identity.Subject == subject {
+			return identity, nil
+		}
+	}
+
+	return nil, errs.ErrNotFound
+}
+
+func (r *fakeUserIdentityRepository) TouchLastLogin(context.Context, string, time.Time) error {
+	return nil
+}
+
+// fakeOIDCStateRepository 為記憶體中的登入狀態，Consume 後即刪除
+type fakeOIDCStateRepository struct {
+	mu     sync.Mutex
+	states map[string]*entity.OIDCLoginState
+}
+
+func (r *fakeOIDCStateRepository) Save(_ context.Context, state *entity.OIDCLoginState, _ time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.states[state.State] = state
+
+	return nil
+}
+
+func (r *fakeOIDCStateRepository) Consume(_ context.Context, state string) (*entity.OIDCLoginState, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	loginState, ok := r.states[state]
+	if !ok {
+		return nil, errs.ErrNotFound
+	}
+	delete(r.states, state)
+
+	return loginState, nil
+}
+
+// fakeHasher 不實際雜湊，OIDC 建立的使用者只需有一組隨機密碼
+type fakeHasher struct {
+	password.Hasher
+}
+
+func (fakeHasher) Hash(password string) (string, error) {
+	return "hashed:" + password, nil
+}
+
+type oidcTest struct {
+	issuer     *identitytest.Issuer
+	uc         *authUseCase
+	users      *fakeUserRepository
+	identities *fakeUserIdentityRepository
+}
+
+func newOIDCTest(t *testing.T) *oidcTest {
+	t.Helper()
+
+	issuer := identitytest.NewIssuer(t)
+
+	cfg := new(config.Config)
+	cfg.Auth.OIDC.Providers = map[string]config.OIDCProviderConfig{
+		testProvider: {
+			Issuer:       issuer.URL(),
+			ClientID:     identitytest.ClientID,
+			ClientSecret: identitytest.ClientSecret,
+			RedirectURL:  identitytest.RedirectURL,
+		},
+		"other": {Issuer: issuer.URL(), ClientID: identitytest.ClientID},
+	}
+
+	test := &oidcTest{
+		issuer:     issuer,
+		users:      &fakeUserRepository{users: make(map[string]*entity.User)},
+		identities: &fakeUserIdentityRepository{},
+	}
+	test.uc = &authUseCase{
+		cfg:               cfg,
+		userRepo:          test.users,
+		userIdentities:    test.identities,
+		oidcStates:        &fakeOIDCStateRepository{states: make(map[string]*entity.OIDCLoginState)},
+		identityProviders: identity.New(identity.Params{Config: cfg}),
+		passwords:         fakeHasher{},
+	}
+
+	return test
+}
+
+// login 以 provider 開始登入，並讓 user 在提供者完成登入，回傳回呼時帶上的 state 與授權碼
+func (o *oidcTest) login(t *testing.T, provider string, user identitytest.User) (string, string) {
+	t.Helper()
+
+	authURL, state, err := o.uc.StartOIDCLogin(context.Background(), provider)
+	if err != nil {
+		t.Fatalf("StartOIDCLogin: %v", err)
+	}
+
+	returnedState, code := o.issuer.Authorize(t, authURL, user)
+	if returnedState != state {
+		t.Fatalf("authorization URL state = %q, want %q", returnedState, state)
+	}
+
+	return state, code
+}
+
+func TestCompleteOIDCLoginCreatesUser(t *testing.T) {
+	test := newOIDCTest(t)
+	state, code := test.login(t, testProvider, identitytest.User{
+		Subject:       "subject-1",
+		Email:         "alice@example.com",
+		EmailVerified: true,
+		Name:          "Alice",
+	})
+
+	user, err := test.uc.CompleteOIDCLogin(context.Background(), testProvider, state, code)
+	if err != nil {
+		t.Fatalf("CompleteOIDCLogin: %v", err)
+	}
+
+	if user.Email != "alice@example.com" || user.Name != "Alice" || user.Status != userstatus.UserStatusActive {
+		t.Errorf("created user = %+v", user)
+	}
+	if _, err := test.identities.FindByProviderSubject(context.Background(), testProvider, "subject-1"); err != nil {
+		t.Errorf("identity was not linked: %v", err)
+	}
+
+	// 再次登入時沿用已連結的使用者
+	state, code = test.login(t, testProvider, identitytest.User{Subject: "subject-1"})
+	again, err := test.uc.CompleteOIDCLogin(context.Background(), testProvider, state, code)
+	if err != nil {
+		t.Fatalf("second CompleteOIDCLogin: %v", err)
+	}
+	if again.ID != user.ID {
+		t.Errorf("second login returned user %s, want %s", again.ID, user.ID)
+	}
+}
+
+func TestCompleteOIDCLoginLinksVerifiedEmail(t *testing.T) {
+	test := newOIDCTest(t)
+	existing := &entity.User{
+		ID:     uuid.New().String(),
+		Email:  "bob@example.com",
+		Status: userstatus.UserStatusPendingVerification,
+	}
+	if err := test.users.Create(context.Background(), existing); err != nil {
+		t.Fatal(err)
+	}
+
+	state, code := test.login(t, testProvider, identitytest.User{
+		Subject:       "subject-2",
+		Email:         "bob@example.com",
+		EmailVerified: true,
+	})
+
+	user, err := test.uc.CompleteOIDCLogin(context.Background(), testProvider, state, code)
+	if err != nil {
+		t.Fatalf("CompleteOIDCLogin: %v", err)
+	}
+
+	if user.ID != existing.ID {
+		t.Errorf("logged in as %s, want existing user %s", user.ID, existing.ID)
+	}
+	if user.Status != userstatus.UserStatusActive {
+		t.Errorf("user status = %s, want active after provider verified the email", user.Status)
+	}
+	linked, err := test.identities.FindByProviderSubject(context.Background(), testProvider, "subject-2")
+	if err != nil || linked.UserID != existing.ID {
+		t.Errorf("identity linked to %+v (err %v), want user %s", linked, err, existing.ID)
+	}
+}
+
+// TestCompleteOIDCLoginReplacesPendingUserPassword 確認他人搶先以同一 email 註冊的未驗證帳號，
+// 經 OIDC 啟用後無法再以註冊時的密碼登入
+func TestCompleteOIDCLoginReplacesPendingUserPassword(t *testing.T) {
+	test := newOIDCTest(t)
+	const attackerPassword = "hashed:attacker-password"
+	existing := &entity.User{
+		ID:       uuid.New().String(),
+		Email:    "erin@example.com",
+		Password: attackerPassword,
+		Status:   userstatus.UserStatusPendingVerification,
+	}
+	if err := test.users.Create(context.Background(), existing); err != nil {
+		t.Fatal(err)
+	}
+
+	state, code := test.login(t, testProvider, identitytest.User{
+		Subject:       "subject-erin",
+		Email:         "erin@example.com",
+		EmailVerified: true,
+	})
+
+	if _, err := test.uc.CompleteOIDCLogin(context.Background(), testProvider, state, code); err != nil {
+		t.Fatalf("CompleteOIDCLogin: %v", err)
+	}
+
+	stored, err := test.users.FindByID(context.Background(), existing.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Status != userstatus.UserStatusActive {
+		t.Errorf("user status = %s, want active", stored.Status)
+	}
+	if stored.Password == attackerPassword || stored.Password == "" {
+		t.Errorf("password = %q, want a new random password", stored.Password)
+	}
+}
+
+func TestCompleteOIDCLoginRejectsUnverifiedEmail(t *testing.T) {
+	test := newOIDCTest(t)
+	existing := &entity.User{
+		ID:     uuid.New().String(),
+		Email:  "carol@example.com",
+		Status: userstatus.UserStatusActive,
+	}
+	if err := test.users.Create(context.Background(), existing); err != nil {
+		t.Fatal(err)
+	}
+
+	state, code := test.login(t, testProvider, identitytest.User{
+		Subject: "attacker",
+		Email:   "carol@example.com",
+	})
+
+	_, err := test.uc.CompleteOIDCLogin(context.Background(), testProvider, state, code)
+	if errs.KindOf(err) != errs.KindPermissionDenied {
+		t.Fatalf("CompleteOIDCLogin error = %v, want permission denied", err)
+	}
+	if len(test.identities.identities) != 0 {
+		t.Errorf("unverified identity was linked: %+v", test.identities.identities)
+	}
+}
+
+func TestCompleteOIDCLoginRejectsStateMismatch(t *testing.T) {
+	user := identitytest.User{Subject: "subject-3", Email: "dave@example.com", EmailVerified: true}
+
+	t.Run("unknown state", func(t *testing.T) {
+		test := newOIDCTest(t)
+		_, code := test.login(t, testProvider, user)
+
+		_, err := test.uc.CompleteOIDCLogin(context.Background(), testProvider, "forged-state", code)
+		if errs.KindOf(err) != errs.KindInvalidCredentials {
+			t.Fatalf("CompleteOIDCLogin error = %v, want invalid credentials", err)
+		}
+	})
+
+	t.Run("state issued for another provider", func(t *testing.T) {
+		test := newOIDCTest(t)
+		state, code := test.login(t, "other", user)
+
+		_, err := test.uc.CompleteOIDCLogin(context.Background(), testProvider, state, code)
+		if errs.KindOf(err) != errs.KindInvalidCredentials {
+			t.Fatalf("CompleteOIDCLogin error = %v, want invalid credentials", err)
+		}
+	})
+
+	t.Run("state already used", func(t *testing.T) {
+		test := newOIDCTest(t)
+		state, code := test.login(t, testProvider, user)
+
+		if _, err := test.uc.CompleteOIDCLogin(context.Background(), testProvider, state, code); err != nil {
+			t.Fatalf("first CompleteOIDCLogin: %v", err)
+		}
+		_, err := test.uc.CompleteOIDCLogin(context.Background(), testProvider, state, code)
+		if errs.KindOf(err) != errs.KindInvalidCredentials {
+			t.Fatalf("replayed CompleteOIDCLogin error = %v, want invalid credentials", err)
+		}
+	})
+}
+
+func TestCompleteOIDCLoginRejectsNonceMismatch(t *testing.T) {
+	test := newOIDCTest(t)
+	state, code := test.login(t, testProvider, identitytest.User{
+		Subject:       "subject-4",
+		Email:         "erin@example.com",
+		EmailVerified: true,
+		Nonce:         "replayed-nonce",
+	})
+
+	_, err := test.uc.CompleteOIDCLogin(context.Background(), testProvider, state, code)
+	if errs.KindOf(err) != errs.KindInvalidCredentials {
+		t.Fatalf("CompleteOIDCLogin error = %v, want invalid credentials", err)
+	}
+	if len(test.users.users) != 0 {
+		t.Errorf("user was created despite the nonce mismatch")
+	}
+}
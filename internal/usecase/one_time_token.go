@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/url"
+	"time"
+
+	"server-template/internal/domain/entity"
+
+	"github.com/pkg/errors"
+)
+
+// oneTimeTokenBytes 為一次性 token 的隨機位元組長度
+const oneTimeTokenBytes = 32
+
+// issueOneTimeToken 生成一次性 token，僅將其雜湊值存儲於 Redis
+func (uc *authUseCase) issueOneTimeToken(ctx context.Context, purpose entity.OneTimeTokenPurpose, userID string, ttl time.Duration) (string, error) {
+	buf := make([]byte, oneTimeTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", errors.Wrap(err, "failed to generate one-time token")
+	}
+	token := base64.RawURLEncoding.EncodeToString(buf)
+
+	if err := uc.oneTimeTokens.Save(ctx, purpose, hashOneTimeToken(token), userID, ttl); err != nil {
+		return "", errors.Wrap(err, "failed to store one-time token")
+	}
+
+	return token, nil
+}
+
+func hashOneTimeToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// oneTimeTokenLink 將 token 附加於前端頁面網址，未設定網址時直接回傳 token
+func oneTimeTokenLink(baseURL, token string) string {
+	if baseURL == "" {
+		return token
+	}
+
+	link, err := url.Parse(baseURL)
+	if err != nil {
+		return token
+	}
+
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	return link.String()
+}
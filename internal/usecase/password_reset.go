@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"server-template/internal/domain/entity"
+	userstatus "server-template/internal/domain/entity/user"
+	"server-template/internal/domain/errs"
+	"server-template/internal/domain/notification"
+
+	"github.com/pkg/errors"
+)
+
+const defaultPasswordResetTTL = 30 * time.Minute
+
+var errResetTokenInvalid = errs.FieldError("token", errors.New("reset token is invalid or has expired"))
+
+// RequestPasswordReset 發送重設密碼 token 給使用者。
+// 為避免洩漏帳號是否存在，email 不存在時同樣回傳成功。
+func (uc *authUseCase) RequestPasswordReset(ctx context.Context, email string) error {
+	user, err := uc.userRepo.FindByEmail(ctx, email)
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to find user")
+	}
+	if user.Status == userstatus.UserStatusDeleted {
+		return nil
+	}
+
+	ttl := uc.cfg.Auth.PasswordReset.TTL
+	if ttl <= 0 {
+		ttl = defaultPasswordResetTTL
+	}
+
+	token, err := uc.issueOneTimeToken(ctx, entity.OneTimeTokenPasswordReset, user.ID, ttl)
+	if err != nil {
+		return err
+	}
+
+	msg := &notification.Message{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf(
+			"Use the following link to reset your password. It expires in %s and can only be used once.\n\n%s",
+			ttl, oneTimeTokenLink(uc.cfg.Auth.PasswordReset.URL, token),
+		),
+	}
+	if err := uc.notifier.Send(ctx, msg); err != nil {
+		return errors.Wrap(err, "failed to send password reset notification")
+	}
+
+	return nil
+}
+
+// ResetPassword 以重設密碼 token 設定新密碼，token 使用後即失效
+func (uc *authUseCase) ResetPassword(ctx context.Context, token, newPassword string) (*entity.User, error) {
+	tokenHash := hashOneTimeToken(token)
+
+	// 先以 token 找到使用者並完整檢查新密碼，密碼不合規時 token 仍可再次使用
+	userID, err := uc.oneTimeTokens.Find(ctx, entity.OneTimeTokenPasswordReset, tokenHash)
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil, errResetTokenInvalid
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find reset token")
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find user by ID")
+	}
+	if user.Status == userstatus.UserStatusDeleted {
+		return nil, errResetTokenInvalid
+	}
+
+	if err := uc.passwordPolicy.Validate(ctx, "new_password", newPassword, user.Email, user.Name); err != nil {
+		return nil, errors.Wrap(err, "invalid password")
+	}
+
+	// 同一 token 的並行請求只有一個能取得 token
+	consumedUserID, err := uc.oneTimeTokens.Consume(ctx, entity.OneTimeTokenPasswordReset, tokenHash)
+	if errors.Is(err, errs.ErrNotFound) || (err == nil && consumedUserID != user.ID) {
+		return nil, errResetTokenInvalid
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to consume reset token")
+	}
+
+	user.Password = newPassword
+	if err := uc.hashPassword(user); err != nil {
+		return nil, err
+	}
+
+	if err := uc.userRepo.UpdatePassword(ctx, user.ID, user.Password); err != nil {
+		return nil, errors.Wrap(err, "failed to update password")
+	}
+	uc.recordUserEvent(ctx, entity.AuditEventPasswordReset, user.ID)
+
+	return user, nil
+}
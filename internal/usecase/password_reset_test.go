@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"server-template/config"
+	"server-template/internal/domain/entity"
+	userstatus "server-template/internal/domain/entity/user"
+	"server-template/internal/domain/errs"
+	"server-template/internal/domain/repository"
+
+	"github.com/pkg/errors"
+)
+
+// fakeOneTimeTokenRepository 為記憶體中的一次性 token，以雜湊值對應使用者 ID
+type fakeOneTimeTokenRepository struct {
+	repository.OneTimeTokenRepository
+
+	tokens map[string]string
+}
+
+func (r *fakeOneTimeTokenRepository) Find(_ context.Context, _ entity.OneTimeTokenPurpose, tokenHash string) (string, error) {
+	userID, ok := r.tokens[tokenHash]
+	if !ok {
+		return "", errs.ErrNotFound
+	}
+
+	return userID, nil
+}
+
+func (r *fakeOneTimeTokenRepository) Consume(ctx context.Context, purpose entity.OneTimeTokenPurpose, tokenHash string) (string, error) {
+	userID, err := r.Find(ctx, purpose, tokenHash)
+	delete(r.tokens, tokenHash)
+
+	return userID, err
+}
+
+// nameRejectingPolicy 拒絕包含使用者資訊的密碼
+type nameRejectingPolicy struct{}
+
+func (nameRejectingPolicy) Validate(_ context.Context, field, candidate string, userInputs ...string) error {
+	for _, input := range userInputs {
+		if input != "" && candidate == input {
+			return errs.FieldError(field, errors.New("password must not contain personal information"))
+		}
+	}
+
+	return nil
+}
+
+func TestResetPasswordKeepsTokenWhenPasswordRejected(t *testing.T) {
+	users := &fakeUserRepository{users: make(map[string]*entity.User)}
+	user := &entity.User{ID: "user-1", Name: "alice", Email: "alice@example.com", Status: userstatus.UserStatusActive}
+	if err := users.Create(context.Background(), user); err != nil {
+		t.Fatal(err)
+	}
+
+	tokens := &fakeOneTimeTokenRepository{tokens: map[string]string{hashOneTimeToken("token"): user.ID}}
+	uc := &authUseCase{
+		cfg:            new(config.Config),
+		userRepo:       users,
+		oneTimeTokens:  tokens,
+		passwordPolicy: nameRejectingPolicy{},
+	}
+
+	_, err := uc.ResetPassword(context.Background(), "token", "alice")
+	if errs.KindOf(err) != errs.KindValidationFailed {
+		t.Fatalf("ResetPassword error = %v, want validation failed", err)
+	}
+	if len(tokens.tokens) != 1 {
+		t.Errorf("reset token was consumed by a rejected password")
+	}
+}
@@ -0,0 +1,195 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"server-template/internal/domain/entity"
+	userstatus "server-template/internal/domain/entity/user"
+	"server-template/internal/domain/errs"
+	"server-template/internal/domain/notification"
+
+	"github.com/pkg/errors"
+)
+
+var errEmailTaken = errs.New(errs.KindAlreadyExists, "email address is already in use")
+
+// UpdateProfile 更新使用者的名稱與 email，參數為 nil 的欄位維持不變。
+// 變更 email 時新 email 會先記錄為待驗證，寄送驗證連結並通過 VerifyEmail 後才會取代原本的 email。
+func (uc *authUseCase) UpdateProfile(ctx context.Context, userID string, name, email *string) (*entity.User, error) {
+	user, err := uc.activeUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if name != nil {
+		user.Name = *name
+	}
+	if err := user.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid user data")
+	}
+
+	var emailChanged bool
+	if email != nil {
+		if *email == user.Email {
+			// 改回目前的 email 視同取消尚未驗證的變更
+			user.PendingEmail = nil
+		} else {
+			candidate := &entity.User{Email: *email}
+			if err := candidate.Validate(); err != nil {
+				return nil, errors.Wrap(err, "invalid user data")
+			}
+			if err := uc.checkEmailAvailable(ctx, *email); err != nil {
+				return nil, err
+			}
+			user.PendingEmail = email
+			emailChanged = true
+		}
+	}
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, errors.Wrap(err, "failed to update user")
+	}
+
+	if emailChanged {
+		if err := uc.sendEmailChange(ctx, user); err != nil {
+			return nil, err
+		}
+	}
+
+	return user, nil
+}
+
+// ChangePassword 驗證目前的密碼後設定新密碼
+func (uc *authUseCase) ChangePassword(ctx context.Context, userID, currentPassword, newPassword string) error {
+	user, err := uc.activeUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	match, _, err := uc.passwords.Verify(currentPassword, user.Password)
+	if err != nil {
+		return errors.Wrap(err, "failed to verify password")
+	}
+	if !match {
+		return errs.FieldError("current_password", errors.New("current password is incorrect"))
+	}
+
+	if err := uc.passwordPolicy.Validate(ctx, "new_password", newPassword, user.Email, user.Name); err != nil {
+		return errors.Wrap(err, "invalid password")
+	}
+
+	user.Password = newPassword
+	if err := uc.hashPassword(user); err != nil {
+		return err
+	}
+
+	if err := uc.userRepo.UpdatePassword(ctx, user.ID, user.Password); err != nil {
+		return errors.Wrap(err, "failed to update password")
+	}
+	uc.recordUserEvent(ctx, entity.AuditEventPasswordChange, user.ID)
+
+	return nil
+}
+
+// activeUser 取得可以變更個人資料的使用者
+func (uc *authUseCase) activeUser(ctx context.Context, userID string) (*entity.User, error) {
+	user, err := uc.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkUserStatus(user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// checkEmailAvailable 檢查 email 是否已被其他使用者使用
+func (uc *authUseCase) checkEmailAvailable(ctx context.Context, email string) error {
+	_, err := uc.userRepo.FindByEmail(ctx, email)
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to check existing user")
+	}
+
+	return errEmailTaken
+}
+
+// confirmEmailChange 以 email 變更 token 將待驗證的 email 設為使用者的 email，並通知原本的 email
+func (uc *authUseCase) confirmEmailChange(ctx context.Context, token string) (*entity.User, error) {
+	userID, err := uc.oneTimeTokens.Consume(ctx, entity.OneTimeTokenEmailChange, hashOneTimeToken(token))
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil, errVerificationTokenInvalid
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to consume email change token")
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find user by ID")
+	}
+	if user.PendingEmail == nil || user.Status == userstatus.UserStatusDeleted {
+		return nil, errVerificationTokenInvalid
+	}
+
+	// 申請變更後 email 可能已被其他使用者註冊
+	if err := uc.checkEmailAvailable(ctx, *user.PendingEmail); err != nil {
+		return nil, err
+	}
+
+	previous := user.Email
+	user.Email = *user.PendingEmail
+	user.PendingEmail = nil
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		if errs.KindOf(err) == errs.KindAlreadyExists {
+			return nil, errEmailTaken
+		}
+
+		return nil, errors.Wrap(err, "failed to update user")
+	}
+
+	msg := &notification.Message{
+		To:      previous,
+		Subject: "Your email address has been changed",
+		Body: fmt.Sprintf(
+			"The email address of your account has been changed to %s. If you did not make this change, please reset your password immediately.",
+			user.Email,
+		),
+	}
+	if err := uc.notifier.Send(ctx, msg); err != nil {
+		return nil, errors.Wrap(err, "failed to send email change notification")
+	}
+
+	return user, nil
+}
+
+// sendEmailChange 生成 email 變更 token 並寄送至待驗證的 email
+func (uc *authUseCase) sendEmailChange(ctx context.Context, user *entity.User) error {
+	ttl := uc.cfg.Auth.EmailVerification.TTL
+	if ttl <= 0 {
+		ttl = defaultEmailVerificationTTL
+	}
+
+	token, err := uc.issueOneTimeToken(ctx, entity.OneTimeTokenEmailChange, user.ID, ttl)
+	if err != nil {
+		return err
+	}
+
+	msg := &notification.Message{
+		To:      *user.PendingEmail,
+		Subject: "Confirm your new email address",
+		Body: fmt.Sprintf(
+			"Use the following link to confirm your new email address. It expires in %s.\n\n%s",
+			ttl, oneTimeTokenLink(uc.cfg.Auth.EmailVerification.URL, token),
+		),
+	}
+	if err := uc.notifier.Send(ctx, msg); err != nil {
+		return errors.Wrap(err, "failed to send email change notification")
+	}
+
+	return nil
+}
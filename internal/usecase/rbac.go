@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	"server-template/internal/domain/entity"
+	"server-template/internal/domain/errs"
+
+	"github.com/pkg/errors"
+)
+
+// GetUserAccess 彙整使用者經由角色取得的權限，用於簽發 token
+func (uc *authUseCase) GetUserAccess(ctx context.Context, userID string) (*entity.Access, error) {
+	roles, err := uc.roleRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find user roles")
+	}
+
+	access := &entity.Access{
+		Roles:       make([]string, 0, len(roles)),
+		Permissions: []string{},
+	}
+	for _, role := range roles {
+		access.Roles = append(access.Roles, role.Name)
+		for _, permission := range role.Permissions {
+			access.Permissions = append(access.Permissions, permission.Name)
+		}
+	}
+
+	// 多個角色可能擁有相同的權限
+	slices.Sort(access.Permissions)
+	access.Permissions = slices.Compact(access.Permissions)
+
+	return access, nil
+}
+
+// GrantRole 將角色授予使用者，已擁有該角色時視為成功。
+// 變更會在使用者下次取得或更新 token 時生效。
+func (uc *authUseCase) GrantRole(ctx context.Context, userID, roleName string) error {
+	if _, err := uc.GetUserByID(ctx, userID); err != nil {
+		return err
+	}
+
+	role, err := uc.findRole(ctx, roleName)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.roleRepo.AssignToUser(ctx, userID, role.ID); err != nil {
+		return errors.Wrap(err, "failed to assign role")
+	}
+
+	return nil
+}
+
+// RevokeRole 移除使用者的角色，使用者未擁有該角色時回傳 NOT_FOUND
+func (uc *authUseCase) RevokeRole(ctx context.Context, userID, roleName string) error {
+	role, err := uc.findRole(ctx, roleName)
+	if err != nil {
+		return err
+	}
+
+	removed, err := uc.roleRepo.RemoveFromUser(ctx, userID, role.ID)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove role")
+	}
+	if !removed {
+		return errs.New(errs.KindNotFound, "user does not have role "+roleName)
+	}
+
+	return nil
+}
+
+func (uc *authUseCase) findRole(ctx context.Context, name string) (*entity.Role, error) {
+	role, err := uc.roleRepo.FindByName(ctx, name)
+	if errors.Is(err, errs.ErrNotFound) {
+		return nil, errs.Wrap(err, errs.KindNotFound, "role not found")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find role")
+	}
+
+	return role, nil
+}